@@ -10,9 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
+// set by goreleaser
+var version = "dev"
+
 func main() {
 	var debugMode bool
 
+	provider.Version = version
+
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
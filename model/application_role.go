@@ -0,0 +1,49 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ApplicationRole struct {
+	PrincipalID   int
+	Database      string
+	Name          string
+	Password      string
+	DefaultSchema string
+}
+
+func (role *ApplicationRole) Parse(data *schema.ResourceData) *ApplicationRole {
+	role.PrincipalID = data.Get("principal_id").(int)
+	role.Database = data.Get("database").(string)
+	role.Name = data.Get("name").(string)
+	role.Password = data.Get("password").(string)
+	role.DefaultSchema = data.Get("default_schema").(string)
+	return role
+}
+
+// ToSchema sets the attributes read from the server, the password cannot be read back
+func (role *ApplicationRole) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", role.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("database", role.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("principal_id", role.PrincipalID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_schema", role.DefaultSchema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Assembly is a CLR assembly loaded in a database
+type Assembly struct {
+	AssemblyID int
+	Database   string
+	Name       string
+	// Content holds the bytes of the assembly file, it is not read back
+	Content []byte
+	// PermissionSet is SAFE, EXTERNAL_ACCESS or UNSAFE
+	PermissionSet string
+	Owner         string
+	// Trusted tells whether the hash of the assembly is in the trusted assemblies of the server
+	Trusted bool
+	// Hash is the SHA2_512 hash of the content in lower case hex, as trusted assemblies are identified
+	Hash    string
+	ClrName string
+	Version string
+}
+
+func (a *Assembly) Parse(data *schema.ResourceData) *Assembly {
+	a.Database = data.Get("database").(string)
+	a.Name = data.Get("name").(string)
+	a.PermissionSet = data.Get("permission_set").(string)
+	a.Owner = data.Get("owner").(string)
+	a.Trusted = data.Get("trusted").(bool)
+	a.Hash = data.Get("hash").(string)
+	return a
+}
+
+func (a *Assembly) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", a.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", a.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("permission_set", a.PermissionSet)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("owner", a.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("trusted", a.Trusted)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("hash", a.Hash)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("clr_name", a.ClrName)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("version", a.Version)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("assembly_id", a.AssemblyID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
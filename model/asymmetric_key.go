@@ -0,0 +1,64 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type AsymmetricKey struct {
+	AsymmetricKeyID int
+	Database        string
+	Name            string
+	Algorithm       string
+	// File is the path of a strong name file or of a public key on the server, instead of a generated key
+	File string
+	// Password encrypting the private key, the database master key does when empty
+	Password             string
+	PrivateKeyEncryption string
+	Thumbprint           string
+}
+
+func (key *AsymmetricKey) Parse(data *schema.ResourceData) *AsymmetricKey {
+	key.Database = data.Get("database").(string)
+	key.Name = data.Get("name").(string)
+	key.Algorithm = data.Get("algorithm").(string)
+	key.File = data.Get("file").(string)
+	key.Password = data.Get("password").(string)
+	return key
+}
+
+// ToSchema sets the attributes read from the server, the file and password cannot be read back
+func (key *AsymmetricKey) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("asymmetric_key_id", key.AsymmetricKeyID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("database", key.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", key.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("algorithm", key.Algorithm)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("private_key_encryption", key.PrivateKeyEncryption)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("thumbprint", key.Thumbprint)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
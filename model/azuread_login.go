@@ -0,0 +1,65 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type AzureADLogin struct {
+	Name            string
+	Sid             string
+	Type            string
+	ObjectId        string
+	DefaultDatabase string
+	DefaultLanguage string
+	Disabled        bool
+}
+
+func (login *AzureADLogin) Parse(data *schema.ResourceData) *AzureADLogin {
+	login.Name = data.Get("name").(string)
+	login.ObjectId = data.Get("object_id").(string)
+	login.DefaultDatabase = data.Get("default_database").(string)
+	login.DefaultLanguage = data.Get("default_language").(string)
+	login.Disabled = data.Get("disabled").(bool)
+	return login
+}
+
+func (login *AzureADLogin) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", login.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("sid", login.Sid)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("type", login.Type)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("object_id", login.ObjectId)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_database", login.DefaultDatabase)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_language", login.DefaultLanguage)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("disabled", login.Disabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
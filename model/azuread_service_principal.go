@@ -0,0 +1,66 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AzureADServicePrincipal is a database user of an Azure AD application or managed identity
+type AzureADServicePrincipal struct {
+	PrincipalID   int
+	Database      string
+	Name          string
+	ClientId      string
+	DefaultSchema string
+}
+
+func (sp *AzureADServicePrincipal) Parse(data *schema.ResourceData) *AzureADServicePrincipal {
+	sp.PrincipalID = data.Get("principal_id").(int)
+	sp.Database = data.Get("database").(string)
+	sp.Name = data.Get("name").(string)
+	sp.ClientId = data.Get("client_id").(string)
+	sp.DefaultSchema = data.Get("default_schema").(string)
+	return sp
+}
+
+// User returns the Azure AD user of the service principal, whose SID is derived from its client ID
+func (sp *AzureADServicePrincipal) User() *AzureADUser {
+	return &AzureADUser{
+		PrincipalID:   sp.PrincipalID,
+		Database:      sp.Database,
+		Name:          sp.Name,
+		ObjectId:      sp.ClientId,
+		Type:          UserTypeExternalUser,
+		DefaultSchema: sp.DefaultSchema,
+	}
+}
+
+func (sp *AzureADServicePrincipal) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", sp.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("principal_id", sp.PrincipalID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", sp.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("client_id", sp.ClientId)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_schema", sp.DefaultSchema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Azure AD user types reported by type_desc of sys.database_principals
+const (
+	UserTypeExternalUser  = "EXTERNAL_USER"
+	UserTypeExternalGroup = "EXTERNAL_GROUP"
+)
+
+type AzureADUser struct {
+	PrincipalID   int
+	Database      string
+	Name          string
+	ObjectId      string
+	IsGroup       bool
+	Type          string
+	DefaultSchema string
+}
+
+func (user *AzureADUser) Parse(data *schema.ResourceData) *AzureADUser {
+	user.PrincipalID = data.Get("principal_id").(int)
+	user.Database = data.Get("database").(string)
+	user.Name = data.Get("name").(string)
+	user.ObjectId = data.Get("object_id").(string)
+	user.IsGroup = data.Get("is_group").(bool)
+	user.DefaultSchema = data.Get("default_schema").(string)
+	return user
+}
+
+func (user *AzureADUser) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", user.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("principal_id", user.PrincipalID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", user.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("object_id", user.ObjectId)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("is_group", user.IsGroup)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("type", user.Type)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_schema", user.DefaultSchema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
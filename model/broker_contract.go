@@ -0,0 +1,73 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BrokerContract lists the message types a Service Broker conversation exchanges, and who sends them
+type BrokerContract struct {
+	ContractID int
+	Database   string
+	Name       string
+	Messages   []BrokerContractMessage
+	Owner      string
+}
+
+type BrokerContractMessage struct {
+	MessageType string
+	// SentBy is INITIATOR, TARGET or ANY
+	SentBy string
+}
+
+func (c *BrokerContract) Parse(data *schema.ResourceData) *BrokerContract {
+	c.Database = data.Get("database").(string)
+	c.Name = data.Get("name").(string)
+	c.Messages = make([]BrokerContractMessage, 0)
+	for _, value := range data.Get("message").(*schema.Set).List() {
+		message := value.(map[string]interface{})
+		c.Messages = append(c.Messages, BrokerContractMessage{
+			MessageType: message["message_type"].(string),
+			SentBy:      message["sent_by"].(string),
+		})
+	}
+	c.Owner = data.Get("owner").(string)
+	return c
+}
+
+func (c *BrokerContract) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", c.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", c.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	messages := make([]interface{}, 0, len(c.Messages))
+	for _, message := range c.Messages {
+		messages = append(messages, map[string]interface{}{
+			"message_type": message.MessageType,
+			"sent_by":      message.SentBy,
+		})
+	}
+	err = d.Set("message", messages)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("owner", c.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("contract_id", c.ContractID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
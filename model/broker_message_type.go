@@ -0,0 +1,61 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BrokerMessageType is a Service Broker message type, with the validation of its messages
+type BrokerMessageType struct {
+	MessageTypeID int
+	Database      string
+	Name          string
+	// Validation is NONE, EMPTY, WELL_FORMED_XML or VALID_XML, the latter with an XML schema collection
+	Validation          string
+	XmlSchemaCollection string
+	Owner               string
+}
+
+func (m *BrokerMessageType) Parse(data *schema.ResourceData) *BrokerMessageType {
+	m.Database = data.Get("database").(string)
+	m.Name = data.Get("name").(string)
+	m.Validation = data.Get("validation").(string)
+	m.XmlSchemaCollection = data.Get("xml_schema_collection").(string)
+	m.Owner = data.Get("owner").(string)
+	return m
+}
+
+func (m *BrokerMessageType) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", m.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", m.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("validation", m.Validation)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("xml_schema_collection", m.XmlSchemaCollection)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("owner", m.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("message_type_id", m.MessageTypeID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BrokerQueue stores the messages received by Service Broker services, and activates a procedure processing them
+type BrokerQueue struct {
+	ObjectID              int
+	Database              string
+	Schema                string
+	Name                  string
+	Enabled               bool
+	Retention             bool
+	PoisonMessageHandling bool
+	// Activation is nil for queues without activation procedure
+	Activation *BrokerQueueActivation
+}
+
+type BrokerQueueActivation struct {
+	Enabled bool
+	// Procedure is the schema-qualified name of the stored procedure
+	Procedure       string
+	MaxQueueReaders int
+	// ExecuteAs is SELF, OWNER or the name of a database user
+	ExecuteAs string
+}
+
+func (q *BrokerQueue) Parse(data *schema.ResourceData) *BrokerQueue {
+	q.Database = data.Get("database").(string)
+	q.Schema = data.Get("schema").(string)
+	q.Name = data.Get("name").(string)
+	q.Enabled = data.Get("enabled").(bool)
+	q.Retention = data.Get("retention").(bool)
+	q.PoisonMessageHandling = data.Get("poison_message_handling").(bool)
+	q.Activation = nil
+	for _, value := range data.Get("activation").([]interface{}) {
+		activation := value.(map[string]interface{})
+		q.Activation = &BrokerQueueActivation{
+			Enabled:         activation["enabled"].(bool),
+			Procedure:       activation["procedure"].(string),
+			MaxQueueReaders: activation["max_queue_readers"].(int),
+			ExecuteAs:       activation["execute_as"].(string),
+		}
+	}
+	return q
+}
+
+func (q *BrokerQueue) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", q.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", q.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", q.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("enabled", q.Enabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("retention", q.Retention)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("poison_message_handling", q.PoisonMessageHandling)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	activation := make([]map[string]interface{}, 0, 1)
+	if q.Activation != nil {
+		activation = append(activation, map[string]interface{}{
+			"enabled":           q.Activation.Enabled,
+			"procedure":         q.Activation.Procedure,
+			"max_queue_readers": q.Activation.MaxQueueReaders,
+			"execute_as":        q.Activation.ExecuteAs,
+		})
+	}
+	err = d.Set("activation", activation)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("object_id", q.ObjectID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
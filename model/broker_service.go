@@ -0,0 +1,71 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BrokerService is a Service Broker endpoint, delivering its messages to a queue
+type BrokerService struct {
+	ServiceID   int
+	Database    string
+	Name        string
+	QueueSchema string
+	Queue       string
+	// Contracts the service is the target of, services without contracts can only initiate conversations
+	Contracts []string
+	Owner     string
+}
+
+func (s *BrokerService) Parse(data *schema.ResourceData) *BrokerService {
+	s.Database = data.Get("database").(string)
+	s.Name = data.Get("name").(string)
+	s.QueueSchema = data.Get("queue_schema").(string)
+	s.Queue = data.Get("queue").(string)
+	s.Contracts = make([]string, 0)
+	for _, contract := range data.Get("contracts").(*schema.Set).List() {
+		s.Contracts = append(s.Contracts, contract.(string))
+	}
+	s.Owner = data.Get("owner").(string)
+	return s
+}
+
+func (s *BrokerService) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", s.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", s.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("queue_schema", s.QueueSchema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("queue", s.Queue)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("contracts", s.Contracts)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("owner", s.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("service_id", s.ServiceID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Certificate struct {
+	CertificateID int
+	Database      string
+	Name          string
+	Subject       string
+	StartDate     string
+	ExpiryDate    string
+	// File is the path of a certificate backup on the server, instead of a generated certificate
+	File                         string
+	PrivateKeyFile               string
+	PrivateKeyDecryptionPassword string
+	// PrivateKeyPassword encrypts the private key, the database master key does when empty
+	PrivateKeyPassword   string
+	PrivateKeyEncryption string
+	Thumbprint           string
+}
+
+func (c *Certificate) Parse(data *schema.ResourceData) *Certificate {
+	c.Database = data.Get("database").(string)
+	c.Name = data.Get("name").(string)
+	c.Subject = data.Get("subject").(string)
+	c.StartDate = data.Get("start_date").(string)
+	c.ExpiryDate = data.Get("expiry_date").(string)
+	c.File = data.Get("file").(string)
+	c.PrivateKeyFile = data.Get("private_key_file").(string)
+	c.PrivateKeyDecryptionPassword = data.Get("private_key_decryption_password").(string)
+	c.PrivateKeyPassword = data.Get("private_key_password").(string)
+	return c
+}
+
+// ToSchema sets the attributes read from the server, files and passwords cannot be read back
+func (c *Certificate) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("certificate_id", c.CertificateID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("database", c.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", c.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("subject", c.Subject)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("start_date", c.StartDate)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("expiry_date", c.ExpiryDate)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("private_key_encryption", c.PrivateKeyEncryption)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("thumbprint", c.Thumbprint)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ColumnEncryptionKeyValue is the column encryption key encrypted by a column master key
+type ColumnEncryptionKeyValue struct {
+	ColumnMasterKey string
+	Algorithm       string
+	EncryptedValue  string
+}
+
+type ColumnEncryptionKey struct {
+	ColumnEncryptionKeyID int
+	Database              string
+	Name                  string
+	// Values hold one value per column master key, two while rotating the column master key
+	Values []ColumnEncryptionKeyValue
+}
+
+func (key *ColumnEncryptionKey) Parse(data *schema.ResourceData) *ColumnEncryptionKey {
+	key.Database = data.Get("database").(string)
+	key.Name = data.Get("name").(string)
+	key.Values = ParseColumnEncryptionKeyValues(data.Get("value").(*schema.Set))
+	return key
+}
+
+func ParseColumnEncryptionKeyValues(values *schema.Set) []ColumnEncryptionKeyValue {
+	parsed := make([]ColumnEncryptionKeyValue, 0, values.Len())
+	for _, value := range values.List() {
+		v := value.(map[string]interface{})
+		parsed = append(parsed, ColumnEncryptionKeyValue{
+			ColumnMasterKey: v["column_master_key"].(string),
+			Algorithm:       v["algorithm"].(string),
+			EncryptedValue:  v["encrypted_value"].(string),
+		})
+	}
+	return parsed
+}
+
+func (key *ColumnEncryptionKey) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("column_encryption_key_id", key.ColumnEncryptionKeyID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("database", key.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", key.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	values := make([]interface{}, 0, len(key.Values))
+	for _, value := range key.Values {
+		values = append(values, map[string]interface{}{
+			"column_master_key": value.ColumnMasterKey,
+			"algorithm":         value.Algorithm,
+			"encrypted_value":   value.EncryptedValue,
+		})
+	}
+	err = d.Set("value", values)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
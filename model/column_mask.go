@@ -0,0 +1,72 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ColumnMask is the dynamic data masking function of a table column, and the principals seeing the column unmasked
+type ColumnMask struct {
+	Database string
+	Schema   string
+	Table    string
+	Column   string
+	// Function is the masking function, e.g. email() or partial(1, "XXXX", 0)
+	Function         string
+	UnmaskPrincipals []string
+}
+
+func (m *ColumnMask) Parse(data *schema.ResourceData) *ColumnMask {
+	m.Database = data.Get("database").(string)
+	m.Schema = data.Get("schema").(string)
+	m.Table = data.Get("table").(string)
+	m.Column = data.Get("column").(string)
+	m.Function = data.Get("function").(string)
+	m.UnmaskPrincipals = make([]string, 0)
+	for _, principal := range data.Get("unmask_principals").(*schema.Set).List() {
+		m.UnmaskPrincipals = append(m.UnmaskPrincipals, principal.(string))
+	}
+	return m
+}
+
+func (m *ColumnMask) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", m.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", m.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("table", m.Table)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("column", m.Column)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("function", m.Function)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("unmask_principals", m.UnmaskPrincipals)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
+
+// UnmaskPermission is the UNMASK permission of the principal on the masked column
+func (m *ColumnMask) UnmaskPermission(principal string) *ObjectPermission {
+	p := &ObjectPermission{Schema: m.Schema, Object: m.Table, Columns: []string{m.Column}}
+	p.Database, p.Principal, p.Permission, p.State = m.Database, principal, "UNMASK", PermissionStateGrant
+	return p
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ColumnMasterKey struct {
+	ColumnMasterKeyID    int
+	Database             string
+	Name                 string
+	KeyStoreProviderName string
+	KeyPath              string
+	// Signature of the key path and enclave computations flag, allowing enclave computations when set
+	Signature string
+}
+
+func (key *ColumnMasterKey) Parse(data *schema.ResourceData) *ColumnMasterKey {
+	key.Database = data.Get("database").(string)
+	key.Name = data.Get("name").(string)
+	key.KeyStoreProviderName = data.Get("key_store_provider_name").(string)
+	key.KeyPath = data.Get("key_path").(string)
+	key.Signature = data.Get("signature").(string)
+	return key
+}
+
+func (key *ColumnMasterKey) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("column_master_key_id", key.ColumnMasterKeyID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("database", key.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", key.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("key_store_provider_name", key.KeyStoreProviderName)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("key_path", key.KeyPath)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("signature", key.Signature)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
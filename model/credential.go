@@ -0,0 +1,42 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Credential struct {
+	CredentialID int
+	Name         string
+	Identity     string
+	Secret       string
+}
+
+func (c *Credential) Parse(data *schema.ResourceData) *Credential {
+	c.CredentialID = data.Get("credential_id").(int)
+	c.Name = data.Get("name").(string)
+	c.Identity = data.Get("identity").(string)
+	c.Secret = data.Get("secret").(string)
+	return c
+}
+
+// ToSchema sets the attributes read from the server, the secret cannot be read back
+func (c *Credential) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", c.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("identity", c.Identity)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("credential_id", c.CredentialID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
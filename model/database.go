@@ -9,12 +9,29 @@ type Database struct {
 	Name             string
 	DefaultCollation string
 	Options          OptionsList
+	// CompatibilityLevel is left to the server default when 0
+	CompatibilityLevel int
+	// Containment is NONE or PARTIAL
+	Containment string
+	// Owner is the login owning the database, the creating login by default
+	Owner string
+	// ServiceObjective, ElasticPool and MaxSizeGB are the Azure SQL Database edition options,
+	// ServiceObjective is ElasticPool for databases of an elastic pool
+	ServiceObjective string
+	ElasticPool      string
+	MaxSizeGB        int
 }
 
 func (d *Database) Parse(data *schema.ResourceData) *Database {
 	d.Name = data.Get("name").(string)
 	d.DefaultCollation = data.Get("default_collation").(string)
 	d.Options = make(OptionsList)
+	d.CompatibilityLevel = data.Get("compatibility_level").(int)
+	d.Containment = data.Get("containment").(string)
+	d.Owner = data.Get("owner").(string)
+	d.ServiceObjective = data.Get("service_objective").(string)
+	d.ElasticPool = data.Get("elastic_pool").(string)
+	d.MaxSizeGB = data.Get("max_size_gb").(int)
 	return d
 }
 
@@ -34,5 +51,35 @@ func (d *Database) ToSchema(data *schema.ResourceData) diag.Diagnostics {
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)[0])
 	}
+
+	err = data.Set("compatibility_level", d.CompatibilityLevel)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = data.Set("containment", d.Containment)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = data.Set("owner", d.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = data.Set("service_objective", d.ServiceObjective)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = data.Set("elastic_pool", d.ElasticPool)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = data.Set("max_size_gb", d.MaxSizeGB)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
 	return diags
 }
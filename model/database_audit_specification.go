@@ -0,0 +1,98 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AuditAction is an audited action on a securable by a principal, e.g. SELECT on OBJECT::dbo.orders by public
+type AuditAction struct {
+	Action string
+	// Class of the securable, OBJECT, SCHEMA or DATABASE
+	Class     string
+	Schema    string
+	Object    string
+	Principal string
+}
+
+type DatabaseAuditSpecification struct {
+	Database     string
+	Name         string
+	Audit        string
+	ActionGroups []string
+	Actions      []AuditAction
+	Enabled      bool
+}
+
+func (s *DatabaseAuditSpecification) Parse(data *schema.ResourceData) *DatabaseAuditSpecification {
+	s.Database = data.Get("database").(string)
+	s.Name = data.Get("name").(string)
+	s.Audit = data.Get("audit").(string)
+	s.ActionGroups = make([]string, 0)
+	for _, group := range data.Get("action_groups").(*schema.Set).List() {
+		s.ActionGroups = append(s.ActionGroups, group.(string))
+	}
+	s.Actions = ParseAuditActions(data.Get("action").(*schema.Set))
+	s.Enabled = data.Get("enabled").(bool)
+	return s
+}
+
+func ParseAuditActions(actions *schema.Set) []AuditAction {
+	parsed := make([]AuditAction, 0, actions.Len())
+	for _, action := range actions.List() {
+		a := action.(map[string]interface{})
+		parsed = append(parsed, AuditAction{
+			Action:    a["action"].(string),
+			Class:     a["class"].(string),
+			Schema:    a["schema"].(string),
+			Object:    a["object"].(string),
+			Principal: a["principal"].(string),
+		})
+	}
+	return parsed
+}
+
+func (s *DatabaseAuditSpecification) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", s.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", s.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("audit", s.Audit)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("action_groups", s.ActionGroups)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	actions := make([]interface{}, 0, len(s.Actions))
+	for _, a := range s.Actions {
+		actions = append(actions, map[string]interface{}{
+			"action":    a.Action,
+			"class":     a.Class,
+			"schema":    a.Schema,
+			"object":    a.Object,
+			"principal": a.Principal,
+		})
+	}
+	err = d.Set("action", actions)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("enabled", s.Enabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
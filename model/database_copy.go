@@ -0,0 +1,35 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DatabaseCopy is an Azure SQL database created AS COPY OF another database, on the same or another logical server
+type DatabaseCopy struct {
+	Name           string
+	SourceServer   string
+	SourceDatabase string
+	// ServiceObjective and ElasticPool of the copy, the ones of the source by default
+	ServiceObjective string
+	ElasticPool      string
+}
+
+func (c *DatabaseCopy) Parse(data *schema.ResourceData) *DatabaseCopy {
+	c.Name = data.Get("name").(string)
+	c.SourceServer = data.Get("source_server").(string)
+	c.SourceDatabase = data.Get("source_database").(string)
+	c.ServiceObjective = data.Get("service_objective").(string)
+	c.ElasticPool = data.Get("elastic_pool").(string)
+	return c
+}
+
+func (c *DatabaseCopy) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", c.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type DatabaseEncryption struct {
+	Database    string
+	Algorithm   string
+	Certificate string
+	Enabled     bool
+	// EncryptionState is the description of sys.dm_database_encryption_keys encryption_state, e.g. ENCRYPTED
+	EncryptionState string
+}
+
+func (e *DatabaseEncryption) Parse(data *schema.ResourceData) *DatabaseEncryption {
+	e.Database = data.Get("database").(string)
+	e.Algorithm = data.Get("algorithm").(string)
+	e.Certificate = data.Get("certificate").(string)
+	e.Enabled = data.Get("enabled").(bool)
+	return e
+}
+
+func (e *DatabaseEncryption) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", e.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("algorithm", e.Algorithm)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("certificate", e.Certificate)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("enabled", e.Enabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("encryption_state", e.EncryptionState)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DatabaseFile is a data or log file of a database. Sizes left to 0 take the server defaults.
+type DatabaseFile struct {
+	Database string
+	Name     string
+	// Type is ROWS, LOG or FILESTREAM
+	Type      string
+	Filegroup string
+	Path      string
+	SizeMB    int
+	// MaxSizeMB is -1 for unlimited growth
+	MaxSizeMB     int
+	GrowthMB      int
+	GrowthPercent int
+}
+
+func (f *DatabaseFile) Parse(data *schema.ResourceData) *DatabaseFile {
+	f.Database = data.Get("database").(string)
+	f.Name = data.Get("name").(string)
+	f.Type = data.Get("type").(string)
+	f.Filegroup = data.Get("filegroup").(string)
+	f.Path = data.Get("path").(string)
+	f.SizeMB = data.Get("size_mb").(int)
+	f.MaxSizeMB = data.Get("max_size_mb").(int)
+	f.GrowthMB = data.Get("growth_mb").(int)
+	f.GrowthPercent = data.Get("growth_percent").(int)
+	return f
+}
+
+func (f *DatabaseFile) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", f.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", f.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("type", f.Type)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("filegroup", f.Filegroup)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("path", f.Path)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("size_mb", f.SizeMB)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("max_size_mb", f.MaxSizeMB)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("growth_mb", f.GrowthMB)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("growth_percent", f.GrowthPercent)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DatabaseFirewallRule is an IPv4 address range allowed to connect to an Azure SQL database
+type DatabaseFirewallRule struct {
+	Database       string
+	Name           string
+	StartIPAddress string
+	EndIPAddress   string
+}
+
+func (rule *DatabaseFirewallRule) Parse(data *schema.ResourceData) *DatabaseFirewallRule {
+	rule.Database = data.Get("database").(string)
+	rule.Name = data.Get("name").(string)
+	rule.StartIPAddress = data.Get("start_ip_address").(string)
+	rule.EndIPAddress = data.Get("end_ip_address").(string)
+	return rule
+}
+
+func (rule *DatabaseFirewallRule) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", rule.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", rule.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("start_ip_address", rule.StartIPAddress)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("end_ip_address", rule.EndIPAddress)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DatabaseOptions are the ALTER DATABASE SET options of a database
+type DatabaseOptions struct {
+	Database               string
+	RecoveryModel          string
+	ReadCommittedSnapshot  bool
+	AllowSnapshotIsolation bool
+	AutoClose              bool
+	AutoShrink             bool
+	AutoUpdateStatistics   bool
+	Trustworthy            bool
+	BrokerEnabled          bool
+	PageVerify             string
+}
+
+func (o *DatabaseOptions) Parse(data *schema.ResourceData) *DatabaseOptions {
+	o.Database = data.Get("database").(string)
+	o.RecoveryModel = data.Get("recovery_model").(string)
+	o.ReadCommittedSnapshot = data.Get("read_committed_snapshot").(bool)
+	o.AllowSnapshotIsolation = data.Get("allow_snapshot_isolation").(bool)
+	o.AutoClose = data.Get("auto_close").(bool)
+	o.AutoShrink = data.Get("auto_shrink").(bool)
+	o.AutoUpdateStatistics = data.Get("auto_update_statistics").(bool)
+	o.Trustworthy = data.Get("trustworthy").(bool)
+	o.BrokerEnabled = data.Get("broker_enabled").(bool)
+	o.PageVerify = data.Get("page_verify").(string)
+	return o
+}
+
+func (o *DatabaseOptions) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", o.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("recovery_model", o.RecoveryModel)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("read_committed_snapshot", o.ReadCommittedSnapshot)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("allow_snapshot_isolation", o.AllowSnapshotIsolation)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("auto_close", o.AutoClose)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("auto_shrink", o.AutoShrink)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("auto_update_statistics", o.AutoUpdateStatistics)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("trustworthy", o.Trustworthy)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("broker_enabled", o.BrokerEnabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("page_verify", o.PageVerify)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
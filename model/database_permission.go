@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Permission states of GRANT and DENY statements
+const (
+	PermissionStateGrant = "GRANT"
+	PermissionStateDeny  = "DENY"
+)
+
+// DatabasePermission is one database scope permission of a database principal
+type DatabasePermission struct {
+	Database        string
+	Principal       string
+	Permission      string
+	State           string
+	WithGrantOption bool
+}
+
+func (p *DatabasePermission) Parse(data *schema.ResourceData) *DatabasePermission {
+	p.Database = data.Get("database").(string)
+	p.Principal = data.Get("principal").(string)
+	p.Permission = data.Get("permission").(string)
+	p.State = data.Get("state").(string)
+	p.WithGrantOption = data.Get("with_grant_option").(bool)
+	return p
+}
+
+func (p *DatabasePermission) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", p.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("principal", p.Principal)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("permission", p.Permission)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("state", p.State)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("with_grant_option", p.WithGrantOption)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
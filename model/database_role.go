@@ -0,0 +1,69 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type DatabaseRole struct {
+	PrincipalID int
+	Database    string
+	Name        string
+	IsFixedRole bool
+	Owner       string
+	MemberCount int
+	Members     []string
+}
+
+// ToMap flattens role into the shape used by list attributes of data sources
+func (role *DatabaseRole) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":          role.Name,
+		"principal_id":  role.PrincipalID,
+		"is_fixed_role": role.IsFixedRole,
+		"owner":         role.Owner,
+		"member_count":  role.MemberCount,
+	}
+}
+
+func (role *DatabaseRole) Parse(data *schema.ResourceData) *DatabaseRole {
+	role.PrincipalID = data.Get("principal_id").(int)
+	role.Database = data.Get("database").(string)
+	role.Name = data.Get("name").(string)
+	role.Owner = data.Get("owner").(string)
+	role.Members = make([]string, 0)
+	for _, member := range data.Get("members").(*schema.Set).List() {
+		role.Members = append(role.Members, member.(string))
+	}
+	return role
+}
+
+func (role *DatabaseRole) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", role.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("database", role.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("principal_id", role.PrincipalID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("owner", role.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("members", role.Members)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
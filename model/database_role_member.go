@@ -0,0 +1,40 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DatabaseRoleMember is the membership of one principal in a database role
+type DatabaseRoleMember struct {
+	Database string
+	Role     string
+	Member   string
+}
+
+func (rm *DatabaseRoleMember) Parse(data *schema.ResourceData) *DatabaseRoleMember {
+	rm.Database = data.Get("database").(string)
+	rm.Role = data.Get("role").(string)
+	rm.Member = data.Get("member").(string)
+	return rm
+}
+
+func (rm *DatabaseRoleMember) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", rm.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("role", rm.Role)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("member", rm.Member)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type DatabaseSchema struct {
+	SchemaID         int
+	Database         string
+	Name             string
+	Owner            string
+	OwnerPrincipalID int
+	IsBuiltin        bool
+}
+
+// ToMap flattens schema into the shape used by list attributes of data sources
+func (s *DatabaseSchema) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":               s.Name,
+		"schema_id":          s.SchemaID,
+		"owner":              s.Owner,
+		"owner_principal_id": s.OwnerPrincipalID,
+		"is_builtin":         s.IsBuiltin,
+	}
+}
+
+func (s *DatabaseSchema) Parse(data *schema.ResourceData) *DatabaseSchema {
+	s.Database = data.Get("database").(string)
+	s.Name = data.Get("name").(string)
+	s.Owner = data.Get("owner").(string)
+	return s
+}
+
+func (s *DatabaseSchema) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", s.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", s.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("owner", s.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema_id", s.SchemaID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type DatabaseScopedCredential struct {
+	Credential
+	Database string
+}
+
+func (c *DatabaseScopedCredential) Parse(data *schema.ResourceData) *DatabaseScopedCredential {
+	c.Credential.Parse(data)
+	c.Database = data.Get("database").(string)
+	return c
+}
+
+func (c *DatabaseScopedCredential) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := c.Credential.ToSchema(d)
+	err := d.Set("database", c.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
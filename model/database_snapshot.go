@@ -0,0 +1,42 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DatabaseSnapshot is a read-only snapshot of a source database, with one sparse file per data file of the source
+type DatabaseSnapshot struct {
+	Name           string
+	SourceDatabase string
+	// Directory of the sparse files, the one of each data file of the source by default
+	Directory string
+	Files     []string
+}
+
+func (s *DatabaseSnapshot) Parse(data *schema.ResourceData) *DatabaseSnapshot {
+	s.Name = data.Get("name").(string)
+	s.SourceDatabase = data.Get("source_database").(string)
+	s.Directory = data.Get("directory").(string)
+	return s
+}
+
+func (s *DatabaseSnapshot) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", s.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("source_database", s.SourceDatabase)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("files", s.Files)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DatabaseTrigger is a DDL trigger on DATABASE, fired by the events or event groups
+type DatabaseTrigger struct {
+	Database string
+	Name     string
+	Events   []string
+	Body     string
+	Enabled  bool
+	// Definition is the CREATE TRIGGER statement read back from the server, empty when the trigger is encrypted
+	Definition string
+}
+
+func (t *DatabaseTrigger) Parse(data *schema.ResourceData) *DatabaseTrigger {
+	t.Database = data.Get("database").(string)
+	t.Name = data.Get("name").(string)
+	t.Events = make([]string, 0)
+	for _, event := range data.Get("events").(*schema.Set).List() {
+		t.Events = append(t.Events, event.(string))
+	}
+	t.Body = data.Get("body").(string)
+	t.Enabled = data.Get("enabled").(bool)
+	return t
+}
+
+func (t *DatabaseTrigger) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", t.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", t.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("events", t.Events)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("body", t.Body)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("enabled", t.Enabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("definition", t.Definition)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
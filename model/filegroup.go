@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Types of Filegroup
+const (
+	FilegroupTypeRows            = "ROWS"
+	FilegroupTypeFilestream      = "FILESTREAM"
+	FilegroupTypeMemoryOptimized = "MEMORY_OPTIMIZED_DATA"
+)
+
+type Filegroup struct {
+	Database string
+	Name     string
+	Type     string
+	Default  bool
+	ReadOnly bool
+}
+
+func (fg *Filegroup) Parse(data *schema.ResourceData) *Filegroup {
+	fg.Database = data.Get("database").(string)
+	fg.Name = data.Get("name").(string)
+	fg.Type = data.Get("type").(string)
+	fg.Default = data.Get("default").(bool)
+	fg.ReadOnly = data.Get("read_only").(bool)
+	return fg
+}
+
+func (fg *Filegroup) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", fg.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", fg.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("type", fg.Type)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default", fg.Default)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("read_only", fg.ReadOnly)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
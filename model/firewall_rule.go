@@ -0,0 +1,40 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// FirewallRule is an IPv4 address range allowed to connect to an Azure SQL logical server
+type FirewallRule struct {
+	Name           string
+	StartIPAddress string
+	EndIPAddress   string
+}
+
+func (rule *FirewallRule) Parse(data *schema.ResourceData) *FirewallRule {
+	rule.Name = data.Get("name").(string)
+	rule.StartIPAddress = data.Get("start_ip_address").(string)
+	rule.EndIPAddress = data.Get("end_ip_address").(string)
+	return rule
+}
+
+func (rule *FirewallRule) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", rule.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("start_ip_address", rule.StartIPAddress)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("end_ip_address", rule.EndIPAddress)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
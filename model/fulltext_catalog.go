@@ -0,0 +1,62 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type FulltextCatalog struct {
+	CatalogID int
+	Database  string
+	Name      string
+	// AccentSensitive is nil when not set, the catalog then follows the collation of the database
+	AccentSensitive *bool
+	Default         bool
+	Owner           string
+}
+
+func (c *FulltextCatalog) Parse(data *schema.ResourceData) *FulltextCatalog {
+	c.Database = data.Get("database").(string)
+	c.Name = data.Get("name").(string)
+	c.AccentSensitive = optionalBool(data, "accent_sensitive")
+	c.Default = data.Get("default").(bool)
+	c.Owner = data.Get("owner").(string)
+	return c
+}
+
+func (c *FulltextCatalog) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", c.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", c.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	if c.AccentSensitive != nil {
+		err = d.Set("accent_sensitive", *c.AccentSensitive)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)[0])
+		}
+	}
+
+	err = d.Set("default", c.Default)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("owner", c.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("catalog_id", c.CatalogID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
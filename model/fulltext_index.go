@@ -0,0 +1,94 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type FulltextIndex struct {
+	Database string
+	Schema   string
+	Table    string
+	// KeyIndex is the unique, single-column and non-nullable index identifying the rows of the table
+	KeyIndex string
+	// Catalog is the default full-text catalog of the database when empty
+	Catalog string
+	Columns []FulltextIndexColumn
+	// ChangeTracking is AUTO, MANUAL or OFF
+	ChangeTracking string
+}
+
+type FulltextIndexColumn struct {
+	Name string
+	// Language is the name of the word breaker in sys.fulltext_languages, the default full-text language when empty
+	Language string
+	// TypeColumn holds the file extension of documents stored in varbinary columns
+	TypeColumn string
+}
+
+func (i *FulltextIndex) Parse(data *schema.ResourceData) *FulltextIndex {
+	i.Database = data.Get("database").(string)
+	i.Schema = data.Get("schema").(string)
+	i.Table = data.Get("table").(string)
+	i.KeyIndex = data.Get("key_index").(string)
+	i.Catalog = data.Get("catalog").(string)
+	i.Columns = make([]FulltextIndexColumn, 0)
+	for _, value := range data.Get("column").([]interface{}) {
+		column := value.(map[string]interface{})
+		i.Columns = append(i.Columns, FulltextIndexColumn{
+			Name:       column["name"].(string),
+			Language:   column["language"].(string),
+			TypeColumn: column["type_column"].(string),
+		})
+	}
+	i.ChangeTracking = data.Get("change_tracking").(string)
+	return i
+}
+
+func (i *FulltextIndex) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", i.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", i.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("table", i.Table)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("key_index", i.KeyIndex)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("catalog", i.Catalog)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	columns := make([]map[string]interface{}, 0, len(i.Columns))
+	for _, column := range i.Columns {
+		columns = append(columns, map[string]interface{}{
+			"name":        column.Name,
+			"language":    column.Language,
+			"type_column": column.TypeColumn,
+		})
+	}
+	err = d.Set("column", columns)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("change_tracking", i.ChangeTracking)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Function is a scalar, inline table-valued or multi-statement table-valued user-defined function
+type Function struct {
+	Database string
+	Schema   string
+	Name     string
+	// Parameters are the declarations of the parameters as written, without the enclosing parentheses
+	Parameters string
+	// Returns is the scalar type, TABLE for inline functions, or the table variable of multi-statement functions,
+	// e.g. @result TABLE (id int)
+	Returns       string
+	SchemaBinding bool
+	Body          string
+	// Type is SCALAR_FUNCTION, INLINE_TABLE_VALUED_FUNCTION or TABLE_VALUED_FUNCTION
+	Type string
+	// Definition is the CREATE FUNCTION statement read back from the server, empty when the function is encrypted
+	Definition string
+}
+
+func (f *Function) Parse(data *schema.ResourceData) *Function {
+	f.Database = data.Get("database").(string)
+	f.Schema = data.Get("schema").(string)
+	f.Name = data.Get("name").(string)
+	f.Parameters = data.Get("parameters").(string)
+	f.Returns = data.Get("returns").(string)
+	f.SchemaBinding = data.Get("schemabinding").(bool)
+	f.Body = data.Get("body").(string)
+	return f
+}
+
+func (f *Function) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", f.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", f.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", f.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("parameters", f.Parameters)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("returns", f.Returns)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schemabinding", f.SchemaBinding)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("body", f.Body)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("type", f.Type)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("definition", f.Definition)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
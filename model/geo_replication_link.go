@@ -0,0 +1,68 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Roles of the database of the server in a GeoReplicationLink
+const (
+	GeoReplicationRolePrimary   = "PRIMARY"
+	GeoReplicationRoleSecondary = "SECONDARY"
+)
+
+// GeoReplicationLink is an active geo-replication link between a database and its copy on a partner Azure SQL logical server
+type GeoReplicationLink struct {
+	Database         string
+	PartnerServer    string
+	PartnerDatabase  string
+	AllowConnections string
+	// ServiceObjective of the secondary, the one of the primary by default, only used on creation
+	ServiceObjective string
+	// Role of Database, PRIMARY or SECONDARY
+	Role             string
+	ReplicationState string
+}
+
+func (l *GeoReplicationLink) Parse(data *schema.ResourceData) *GeoReplicationLink {
+	l.Database = data.Get("database").(string)
+	l.PartnerServer = data.Get("partner_server").(string)
+	l.AllowConnections = data.Get("allow_connections").(string)
+	l.ServiceObjective = data.Get("service_objective").(string)
+	return l
+}
+
+func (l *GeoReplicationLink) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", l.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("partner_server", l.PartnerServer)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("partner_database", l.PartnerDatabase)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("allow_connections", l.AllowConnections)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("role", l.Role)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("replication_state", l.ReplicationState)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
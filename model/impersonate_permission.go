@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Scopes of IMPERSONATE permissions
+const (
+	ImpersonateScopeUser  = "USER"
+	ImpersonateScopeLogin = "LOGIN"
+)
+
+// ImpersonatePermission is the IMPERSONATE permission of a principal on a user or login
+type ImpersonatePermission struct {
+	Scope           string
+	Database        string
+	Target          string
+	Principal       string
+	WithGrantOption bool
+}
+
+func (p *ImpersonatePermission) Parse(data *schema.ResourceData) *ImpersonatePermission {
+	p.Scope = data.Get("scope").(string)
+	p.Database = data.Get("database").(string)
+	p.Target = data.Get("target").(string)
+	p.Principal = data.Get("principal").(string)
+	p.WithGrantOption = data.Get("with_grant_option").(bool)
+	return p
+}
+
+func (p *ImpersonatePermission) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("scope", p.Scope)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("database", p.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("target", p.Target)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("principal", p.Principal)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("with_grant_option", p.WithGrantOption)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Index struct {
+	Database  string
+	Schema    string
+	Table     string
+	Name      string
+	Clustered bool
+	Unique    bool
+	Columns   []IndexColumn
+	Include   []string
+	// Filter is the predicate of filtered indexes, without WHERE
+	Filter string
+	// FillFactor is left to the server default when 0
+	FillFactor int
+	// Compression is NONE, ROW or PAGE
+	Compression string
+	// Online builds the index without locking the table, it is not read back
+	Online bool
+}
+
+type IndexColumn struct {
+	Name       string
+	Descending bool
+}
+
+func (i *Index) Parse(data *schema.ResourceData) *Index {
+	i.Database = data.Get("database").(string)
+	i.Schema = data.Get("schema").(string)
+	i.Table = data.Get("table").(string)
+	i.Name = data.Get("name").(string)
+	i.Clustered = data.Get("clustered").(bool)
+	i.Unique = data.Get("unique").(bool)
+	i.Columns = make([]IndexColumn, 0)
+	for _, value := range data.Get("column").([]interface{}) {
+		column := value.(map[string]interface{})
+		i.Columns = append(i.Columns, IndexColumn{
+			Name:       column["name"].(string),
+			Descending: column["descending"].(bool),
+		})
+	}
+	i.Include = make([]string, 0)
+	for _, column := range data.Get("include").([]interface{}) {
+		i.Include = append(i.Include, column.(string))
+	}
+	i.Filter = data.Get("filter").(string)
+	i.FillFactor = data.Get("fill_factor").(int)
+	i.Compression = data.Get("compression").(string)
+	i.Online = data.Get("online").(bool)
+	return i
+}
+
+func (i *Index) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", i.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", i.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("table", i.Table)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", i.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("clustered", i.Clustered)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("unique", i.Unique)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	columns := make([]map[string]interface{}, 0, len(i.Columns))
+	for _, column := range i.Columns {
+		columns = append(columns, map[string]interface{}{
+			"name":       column.Name,
+			"descending": column.Descending,
+		})
+	}
+	err = d.Set("column", columns)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("include", i.Include)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("filter", i.Filter)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("fill_factor", i.FillFactor)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("compression", i.Compression)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -5,19 +5,60 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Login types reported by type_desc of sys.server_principals
+const (
+	LoginTypeSQL           = "SQL_LOGIN"
+	LoginTypeExternalLogin = "EXTERNAL_LOGIN"
+	LoginTypeExternalGroup = "EXTERNAL_GROUP"
+)
+
 type Login struct {
-	Name     string
-	Password string
-	Options  OptionsList
+	Name            string
+	Sid             string
+	Password        string
+	External        bool
+	ObjectId        string
+	Type            string
+	DefaultDatabase string
+	DefaultLanguage string
+	// CheckPolicy and CheckExpiration are nil when not set, or for external logins
+	CheckPolicy     *bool
+	CheckExpiration *bool
+	Disabled        bool
+	// Locked is read from the server, MustChange is only applied on creation
+	Locked     bool
+	MustChange bool
+	Options    OptionsList
 }
 
 func (login *Login) Parse(data *schema.ResourceData) *Login {
 	login.Name = data.Get("name").(string)
+	login.Sid = data.Get("sid").(string)
 	login.Password = data.Get("password").(string)
+	login.External = data.Get("external").(bool)
+	login.ObjectId = data.Get("object_id").(string)
+	login.DefaultDatabase = data.Get("default_database").(string)
+	login.DefaultLanguage = data.Get("default_language").(string)
+	login.CheckPolicy = optionalBool(data, "check_policy")
+	login.CheckExpiration = optionalBool(data, "check_expiration")
+	login.Disabled = data.Get("disabled").(bool)
+	login.Locked = data.Get("locked").(bool)
+	login.MustChange = data.Get("must_change").(bool)
 	login.Options = make(OptionsList).Parse(data.Get("options").(map[string]interface{}))
 	return login
 }
 
+// optionalBool returns the value of the boolean attribute, nil if it is neither configured nor known.
+// GetOkExists is deprecated, but the only way to tell false from unset in this SDK version.
+func optionalBool(data *schema.ResourceData, key string) *bool {
+	value, ok := data.GetOkExists(key)
+	if !ok {
+		return nil
+	}
+	b := value.(bool)
+	return &b
+}
+
 func (login *Login) ToSchema(d *schema.ResourceData) diag.Diagnostics {
 	diags := diag.Diagnostics{}
 	err := d.Set("name", login.Name)
@@ -30,10 +71,59 @@ func (login *Login) ToSchema(d *schema.ResourceData) diag.Diagnostics {
 		diags = append(diags, diag.FromErr(err)[0])
 	}
 
-	err = d.Set("password", login.Password)
+	err = d.Set("external", login.External)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("sid", login.Sid)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)[0])
 	}
 
+	err = d.Set("type", login.Type)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_database", login.DefaultDatabase)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_language", login.DefaultLanguage)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("disabled", login.Disabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("locked", login.Locked)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	if login.CheckPolicy != nil {
+		err = d.Set("check_policy", *login.CheckPolicy)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)[0])
+		}
+	}
+
+	if login.CheckExpiration != nil {
+		err = d.Set("check_expiration", *login.CheckExpiration)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)[0])
+		}
+	}
+
 	return diags
 }
+
+// IsExternal checks whether login type is an Azure AD principal
+func (login *Login) IsExternal() bool {
+	return login.Type == LoginTypeExternalLogin || login.Type == LoginTypeExternalGroup
+}
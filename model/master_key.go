@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type MasterKey struct {
+	Database          string
+	Password          string
+	EncryptedByServer bool
+	KeyGuid           string
+	CreatedAt         string
+}
+
+func (key *MasterKey) Parse(data *schema.ResourceData) *MasterKey {
+	key.Database = data.Get("database").(string)
+	key.Password = data.Get("password").(string)
+	key.EncryptedByServer = data.Get("encrypted_by_server").(bool)
+	return key
+}
+
+// ToSchema sets the attributes read from the server, the password cannot be read back
+func (key *MasterKey) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", key.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("encrypted_by_server", key.EncryptedByServer)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("key_guid", key.KeyGuid)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("created_at", key.CreatedAt)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
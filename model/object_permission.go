@@ -0,0 +1,46 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ObjectPermission is one permission of a database principal on a table, view, procedure or function,
+// or on some of its columns
+type ObjectPermission struct {
+	DatabasePermission
+	Schema  string
+	Object  string
+	Columns []string
+}
+
+func (p *ObjectPermission) Parse(data *schema.ResourceData) *ObjectPermission {
+	p.DatabasePermission.Parse(data)
+	p.Schema = data.Get("schema").(string)
+	p.Object = data.Get("object").(string)
+	p.Columns = make([]string, 0)
+	for _, column := range data.Get("columns").(*schema.Set).List() {
+		p.Columns = append(p.Columns, column.(string))
+	}
+	return p
+}
+
+func (p *ObjectPermission) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := p.DatabasePermission.ToSchema(d)
+	err := d.Set("schema", p.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("object", p.Object)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("columns", p.Columns)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type PartitionFunction struct {
+	Database  string
+	Name      string
+	InputType string
+	// Range is LEFT or RIGHT, the side of the partition each boundary value belongs to
+	Range string
+	// Boundaries are the boundary values in ascending order, as text converted to InputType
+	Boundaries []string
+	// NextUsedFilegroup is set NEXT USED on the partition schemes of the function before splitting ranges
+	NextUsedFilegroup string
+}
+
+func (f *PartitionFunction) Parse(data *schema.ResourceData) *PartitionFunction {
+	f.Database = data.Get("database").(string)
+	f.Name = data.Get("name").(string)
+	f.InputType = data.Get("input_type").(string)
+	f.Range = data.Get("range").(string)
+	f.Boundaries = make([]string, 0)
+	for _, value := range data.Get("boundaries").([]interface{}) {
+		f.Boundaries = append(f.Boundaries, value.(string))
+	}
+	f.NextUsedFilegroup = data.Get("next_used_filegroup").(string)
+	return f
+}
+
+func (f *PartitionFunction) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", f.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", f.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("input_type", f.InputType)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("range", f.Range)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("boundaries", f.Boundaries)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
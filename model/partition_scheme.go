@@ -0,0 +1,57 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type PartitionScheme struct {
+	Database string
+	Name     string
+	Function string
+	// Filegroups of the partitions in order, or the single filegroup of every partition with AllTo
+	Filegroups []string
+	AllTo      string
+}
+
+func (s *PartitionScheme) Parse(data *schema.ResourceData) *PartitionScheme {
+	s.Database = data.Get("database").(string)
+	s.Name = data.Get("name").(string)
+	s.Function = data.Get("function").(string)
+	s.Filegroups = make([]string, 0)
+	for _, fg := range data.Get("filegroups").([]interface{}) {
+		s.Filegroups = append(s.Filegroups, fg.(string))
+	}
+	s.AllTo = data.Get("all_to").(string)
+	return s
+}
+
+func (s *PartitionScheme) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", s.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", s.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("function", s.Function)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("filegroups", s.Filegroups)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("all_to", s.AllTo)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
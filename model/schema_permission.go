@@ -0,0 +1,28 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SchemaPermission is one permission of a database principal on a schema
+type SchemaPermission struct {
+	DatabasePermission
+	Schema string
+}
+
+func (p *SchemaPermission) Parse(data *schema.ResourceData) *SchemaPermission {
+	p.DatabasePermission.Parse(data)
+	p.Schema = data.Get("schema").(string)
+	return p
+}
+
+func (p *SchemaPermission) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := p.DatabasePermission.ToSchema(d)
+	err := d.Set("schema", p.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
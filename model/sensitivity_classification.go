@@ -0,0 +1,82 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SensitivityClassification is the data classification of a table column
+type SensitivityClassification struct {
+	Database          string
+	Schema            string
+	Table             string
+	Column            string
+	Label             string
+	LabelID           string
+	InformationType   string
+	InformationTypeID string
+	Rank              string
+}
+
+func (c *SensitivityClassification) Parse(data *schema.ResourceData) *SensitivityClassification {
+	c.Database = data.Get("database").(string)
+	c.Schema = data.Get("schema").(string)
+	c.Table = data.Get("table").(string)
+	c.Column = data.Get("column").(string)
+	c.Label = data.Get("label").(string)
+	c.LabelID = data.Get("label_id").(string)
+	c.InformationType = data.Get("information_type").(string)
+	c.InformationTypeID = data.Get("information_type_id").(string)
+	c.Rank = data.Get("rank").(string)
+	return c
+}
+
+func (c *SensitivityClassification) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", c.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", c.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("table", c.Table)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("column", c.Column)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("label", c.Label)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("label_id", c.LabelID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("information_type", c.InformationType)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("information_type_id", c.InformationTypeID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("rank", c.Rank)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
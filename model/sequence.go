@@ -0,0 +1,119 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Sequence struct {
+	Database string
+	Schema   string
+	Name     string
+	DataType string
+	// Start, MinValue and MaxValue are left to the server default, derived from DataType and Increment, when nil
+	Start     *int64
+	Increment int64
+	MinValue  *int64
+	MaxValue  *int64
+	Cycle     bool
+	Cached    bool
+	// CacheSize is left to the server default when 0
+	CacheSize    int
+	CurrentValue int64
+}
+
+func (s *Sequence) Parse(data *schema.ResourceData) *Sequence {
+	s.Database = data.Get("database").(string)
+	s.Schema = data.Get("schema").(string)
+	s.Name = data.Get("name").(string)
+	s.DataType = data.Get("data_type").(string)
+	s.Start = optionalInt64(data, "start")
+	s.Increment = int64(data.Get("increment").(int))
+	s.MinValue = optionalInt64(data, "min_value")
+	s.MaxValue = optionalInt64(data, "max_value")
+	s.Cycle = data.Get("cycle").(bool)
+	s.Cached = data.Get("cached").(bool)
+	s.CacheSize = data.Get("cache_size").(int)
+	return s
+}
+
+// optionalInt64 returns nil for the optional computed attributes neither configured nor read yet, unlike zero
+func optionalInt64(data *schema.ResourceData, key string) *int64 {
+	value, ok := data.GetOkExists(key)
+	if !ok {
+		return nil
+	}
+	v := int64(value.(int))
+	return &v
+}
+
+func (s *Sequence) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", s.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", s.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", s.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("data_type", s.DataType)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	if s.Start != nil {
+		err = d.Set("start", *s.Start)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)[0])
+		}
+	}
+
+	err = d.Set("increment", s.Increment)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	if s.MinValue != nil {
+		err = d.Set("min_value", *s.MinValue)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)[0])
+		}
+	}
+
+	if s.MaxValue != nil {
+		err = d.Set("max_value", *s.MaxValue)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)[0])
+		}
+	}
+
+	err = d.Set("cycle", s.Cycle)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("cached", s.Cached)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("cache_size", s.CacheSize)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("current_value", s.CurrentValue)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
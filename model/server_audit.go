@@ -0,0 +1,105 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	AuditTargetFile           = "FILE"
+	AuditTargetApplicationLog = "APPLICATION_LOG"
+	AuditTargetSecurityLog    = "SECURITY_LOG"
+	AuditTargetURL            = "URL"
+)
+
+type ServerAudit struct {
+	AuditGuid string
+	Name      string
+	Target    string
+	// Path is the directory of the audit files, or the URL of the blob container
+	Path             string
+	MaxSizeMB        int
+	MaxRolloverFiles int
+	ReserveDiskSpace bool
+	RetentionDays    int
+	QueueDelay       int
+	OnFailure        string
+	Predicate        string
+	Enabled          bool
+}
+
+func (a *ServerAudit) Parse(data *schema.ResourceData) *ServerAudit {
+	a.Name = data.Get("name").(string)
+	a.Target = data.Get("target").(string)
+	a.Path = data.Get("path").(string)
+	a.MaxSizeMB = data.Get("max_size_mb").(int)
+	a.MaxRolloverFiles = data.Get("max_rollover_files").(int)
+	a.ReserveDiskSpace = data.Get("reserve_disk_space").(bool)
+	a.RetentionDays = data.Get("retention_days").(int)
+	a.QueueDelay = data.Get("queue_delay").(int)
+	a.OnFailure = data.Get("on_failure").(string)
+	a.Predicate = data.Get("predicate").(string)
+	a.Enabled = data.Get("enabled").(bool)
+	return a
+}
+
+// ToSchema sets the attributes read from the server, the retention days of URL targets are kept as configured
+func (a *ServerAudit) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("audit_guid", a.AuditGuid)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", a.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("target", a.Target)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("path", a.Path)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("max_size_mb", a.MaxSizeMB)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("max_rollover_files", a.MaxRolloverFiles)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("reserve_disk_space", a.ReserveDiskSpace)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("queue_delay", a.QueueDelay)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("on_failure", a.OnFailure)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("predicate", a.Predicate)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("enabled", a.Enabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
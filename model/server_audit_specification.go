@@ -0,0 +1,49 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ServerAuditSpecification struct {
+	Name         string
+	Audit        string
+	ActionGroups []string
+	Enabled      bool
+}
+
+func (s *ServerAuditSpecification) Parse(data *schema.ResourceData) *ServerAuditSpecification {
+	s.Name = data.Get("name").(string)
+	s.Audit = data.Get("audit").(string)
+	s.ActionGroups = make([]string, 0)
+	for _, group := range data.Get("action_groups").(*schema.Set).List() {
+		s.ActionGroups = append(s.ActionGroups, group.(string))
+	}
+	s.Enabled = data.Get("enabled").(bool)
+	return s
+}
+
+func (s *ServerAuditSpecification) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", s.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("audit", s.Audit)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("action_groups", s.ActionGroups)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("enabled", s.Enabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ServerPermissionGrant is one server scope permission of a login or server role
+type ServerPermissionGrant struct {
+	Principal       string
+	Permission      string
+	State           string
+	WithGrantOption bool
+}
+
+func (p *ServerPermissionGrant) Parse(data *schema.ResourceData) *ServerPermissionGrant {
+	p.Principal = data.Get("principal").(string)
+	p.Permission = data.Get("permission").(string)
+	p.State = data.Get("state").(string)
+	p.WithGrantOption = data.Get("with_grant_option").(bool)
+	return p
+}
+
+func (p *ServerPermissionGrant) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("principal", p.Principal)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("permission", p.Permission)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("state", p.State)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("with_grant_option", p.WithGrantOption)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,30 @@
+package model
+
+// Scopes the server principal permissions may be read from
+const (
+	PermissionScopeServer         = "server"
+	PermissionScopeMasterDatabase = "master_database"
+)
+
+type ServerPermission struct {
+	Permission string
+	State      string
+}
+
+type ServerPrincipalPermissions struct {
+	PrincipalID   int
+	Name          string
+	Type          string
+	Scope         string
+	IsServerAdmin bool
+	Roles         []string
+	Permissions   []ServerPermission
+}
+
+// ToMap flattens permission into the shape used by list attributes of data sources
+func (p *ServerPermission) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"permission": p.Permission,
+		"state":      p.State,
+	}
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ServerRole struct {
+	PrincipalID int
+	Sid         string
+	Name        string
+	Owner       string
+	IsFixedRole bool
+}
+
+func (role *ServerRole) Parse(data *schema.ResourceData) *ServerRole {
+	role.Sid = data.Get("sid").(string)
+	role.Name = data.Get("name").(string)
+	role.Owner = data.Get("owner").(string)
+	return role
+}
+
+func (role *ServerRole) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", role.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("sid", role.Sid)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("principal_id", role.PrincipalID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("owner", role.Owner)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
+
+// ServerRoleMember is the membership of one login or server role in a server role
+type ServerRoleMember struct {
+	Role   string
+	Member string
+}
+
+func (rm *ServerRoleMember) Parse(data *schema.ResourceData) *ServerRoleMember {
+	rm.Role = data.Get("role").(string)
+	rm.Member = data.Get("member").(string)
+	return rm
+}
+
+func (rm *ServerRoleMember) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("role", rm.Role)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("member", rm.Member)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ServerTrigger is a DDL trigger on ALL SERVER, fired by the events or event groups
+type ServerTrigger struct {
+	Name    string
+	Events  []string
+	Body    string
+	Enabled bool
+	// Definition is the CREATE TRIGGER statement read back from the server, empty when the trigger is encrypted
+	Definition string
+}
+
+func (t *ServerTrigger) Parse(data *schema.ResourceData) *ServerTrigger {
+	t.Name = data.Get("name").(string)
+	t.Events = make([]string, 0)
+	for _, event := range data.Get("events").(*schema.Set).List() {
+		t.Events = append(t.Events, event.(string))
+	}
+	t.Body = data.Get("body").(string)
+	t.Enabled = data.Get("enabled").(bool)
+	return t
+}
+
+func (t *ServerTrigger) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", t.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("events", t.Events)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("body", t.Body)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("enabled", t.Enabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("definition", t.Definition)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type StoredProcedure struct {
+	Database string
+	Schema   string
+	Name     string
+	// Parameters are the declarations of the parameters as written, e.g. @days int = 30, @dry_run bit = 0
+	Parameters string
+	// ExecuteAs is CALLER, SELF, OWNER or a user name, left to the server default CALLER when empty
+	ExecuteAs string
+	Body      string
+	// Definition is the CREATE PROCEDURE statement read back from the server, empty when the procedure is encrypted
+	Definition string
+}
+
+func (p *StoredProcedure) Parse(data *schema.ResourceData) *StoredProcedure {
+	p.Database = data.Get("database").(string)
+	p.Schema = data.Get("schema").(string)
+	p.Name = data.Get("name").(string)
+	p.Parameters = data.Get("parameters").(string)
+	p.ExecuteAs = data.Get("execute_as").(string)
+	p.Body = data.Get("body").(string)
+	return p
+}
+
+func (p *StoredProcedure) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", p.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", p.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", p.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("parameters", p.Parameters)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("execute_as", p.ExecuteAs)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("body", p.Body)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("definition", p.Definition)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type SymmetricKey struct {
+	SymmetricKeyID int
+	Database       string
+	Name           string
+	Algorithm      string
+	KeySource      string
+	IdentityValue  string
+	KeyGuid        string
+	// Certificates, SymmetricKeys and AsymmetricKeys encrypting the key
+	Certificates   []string
+	SymmetricKeys  []string
+	AsymmetricKeys []string
+	// Password encrypting the key, cannot be read back
+	Password string
+}
+
+func (key *SymmetricKey) Parse(data *schema.ResourceData) *SymmetricKey {
+	key.Database = data.Get("database").(string)
+	key.Name = data.Get("name").(string)
+	key.Algorithm = data.Get("algorithm").(string)
+	key.KeySource = data.Get("key_source").(string)
+	key.IdentityValue = data.Get("identity_value").(string)
+	key.Certificates = stringSet(data, "encryption_certificates")
+	key.SymmetricKeys = stringSet(data, "encryption_symmetric_keys")
+	key.AsymmetricKeys = stringSet(data, "encryption_asymmetric_keys")
+	key.Password = data.Get("password").(string)
+	return key
+}
+
+func stringSet(data *schema.ResourceData, key string) []string {
+	values := make([]string, 0)
+	for _, value := range data.Get(key).(*schema.Set).List() {
+		values = append(values, value.(string))
+	}
+	return values
+}
+
+// ToSchema sets the attributes read from the server, the key source, identity value and password cannot be read back
+func (key *SymmetricKey) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("symmetric_key_id", key.SymmetricKeyID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("database", key.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", key.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("algorithm", key.Algorithm)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("key_guid", key.KeyGuid)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("encryption_certificates", key.Certificates)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("encryption_symmetric_keys", key.SymmetricKeys)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("encryption_asymmetric_keys", key.AsymmetricKeys)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
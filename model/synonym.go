@@ -0,0 +1,76 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Synonym is an alternative name of the base object, named with up to four parts
+type Synonym struct {
+	Database string
+	Schema   string
+	Name     string
+	// BaseServer is the linked server of four-part names, BaseDatabase is the current database when empty
+	BaseServer   string
+	BaseDatabase string
+	BaseSchema   string
+	BaseObject   string
+	// BaseObjectName is the name of the base object as stored by the server, e.g. [app].[dbo].[orders]
+	BaseObjectName string
+}
+
+func (s *Synonym) Parse(data *schema.ResourceData) *Synonym {
+	s.Database = data.Get("database").(string)
+	s.Schema = data.Get("schema").(string)
+	s.Name = data.Get("name").(string)
+	s.BaseServer = data.Get("base_server").(string)
+	s.BaseDatabase = data.Get("base_database").(string)
+	s.BaseSchema = data.Get("base_schema").(string)
+	s.BaseObject = data.Get("base_object").(string)
+	return s
+}
+
+func (s *Synonym) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", s.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", s.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", s.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("base_server", s.BaseServer)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("base_database", s.BaseDatabase)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("base_schema", s.BaseSchema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("base_object", s.BaseObject)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("base_object_name", s.BaseObjectName)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
@@ -0,0 +1,209 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Table struct {
+	Database string
+	Schema   string
+	Name     string
+	Columns  []TableColumn
+	// PrimaryKey is nil for heaps without primary key
+	PrimaryKey *TableKey
+	Unique     []TableKey
+	Checks     []TableCheck
+}
+
+type TableColumn struct {
+	Name              string
+	Type              string
+	Nullable          bool
+	Identity          bool
+	IdentitySeed      int64
+	IdentityIncrement int64
+	// Default is the expression of the default constraint of the column, none when empty
+	Default string
+}
+
+// TableKey is a PRIMARY KEY or UNIQUE constraint, Name is generated by the server when empty
+type TableKey struct {
+	Name      string
+	Columns   []string
+	Clustered bool
+}
+
+type TableCheck struct {
+	Name       string
+	Expression string
+}
+
+func (t *Table) Parse(data *schema.ResourceData) *Table {
+	return t.parse(data, data.Get)
+}
+
+// ParseOld parses the columns and constraints of the table before the planned changes
+func (t *Table) ParseOld(data *schema.ResourceData) *Table {
+	return t.parse(data, func(key string) interface{} {
+		old, _ := data.GetChange(key)
+		return old
+	})
+}
+
+func (t *Table) parse(data *schema.ResourceData, get func(string) interface{}) *Table {
+	t.Database = data.Get("database").(string)
+	t.Schema = data.Get("schema").(string)
+	t.Name = data.Get("name").(string)
+
+	t.Columns = make([]TableColumn, 0)
+	for _, value := range get("column").([]interface{}) {
+		column := value.(map[string]interface{})
+		t.Columns = append(t.Columns, TableColumn{
+			Name:              column["name"].(string),
+			Type:              column["type"].(string),
+			Nullable:          column["nullable"].(bool),
+			Identity:          column["identity"].(bool),
+			IdentitySeed:      int64(column["identity_seed"].(int)),
+			IdentityIncrement: int64(column["identity_increment"].(int)),
+			Default:           column["default"].(string),
+		})
+	}
+
+	t.PrimaryKey = nil
+	if keys := get("primary_key").([]interface{}); len(keys) > 0 && keys[0] != nil {
+		key := parseTableKey(keys[0].(map[string]interface{}))
+		t.PrimaryKey = &key
+	}
+
+	t.Unique = make([]TableKey, 0)
+	for _, value := range get("unique").([]interface{}) {
+		t.Unique = append(t.Unique, parseTableKey(value.(map[string]interface{})))
+	}
+
+	t.Checks = make([]TableCheck, 0)
+	for _, value := range get("check").([]interface{}) {
+		check := value.(map[string]interface{})
+		t.Checks = append(t.Checks, TableCheck{
+			Name:       check["name"].(string),
+			Expression: check["expression"].(string),
+		})
+	}
+	return t
+}
+
+func parseTableKey(key map[string]interface{}) TableKey {
+	k := TableKey{Name: key["name"].(string), Columns: make([]string, 0)}
+	for _, column := range key["columns"].([]interface{}) {
+		k.Columns = append(k.Columns, column.(string))
+	}
+	if clustered, ok := key["clustered"]; ok {
+		k.Clustered = clustered.(bool)
+	}
+	return k
+}
+
+func (k TableKey) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":      k.Name,
+		"columns":   k.Columns,
+		"clustered": k.Clustered,
+	}
+}
+
+func (t *Table) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", t.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", t.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", t.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	columns := make([]map[string]interface{}, 0, len(t.Columns))
+	for _, column := range t.Columns {
+		columns = append(columns, map[string]interface{}{
+			"name":               column.Name,
+			"type":               column.Type,
+			"nullable":           column.Nullable,
+			"identity":           column.Identity,
+			"identity_seed":      column.IdentitySeed,
+			"identity_increment": column.IdentityIncrement,
+			"default":            column.Default,
+		})
+	}
+	err = d.Set("column", columns)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	primaryKey := make([]map[string]interface{}, 0, 1)
+	if t.PrimaryKey != nil {
+		primaryKey = append(primaryKey, t.PrimaryKey.toMap())
+	}
+	err = d.Set("primary_key", primaryKey)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	unique := make([]map[string]interface{}, 0, len(t.Unique))
+	for _, key := range t.Unique {
+		unique = append(unique, key.toMap())
+	}
+	err = d.Set("unique", unique)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	checks := make([]map[string]interface{}, 0, len(t.Checks))
+	for _, check := range t.Checks {
+		checks = append(checks, map[string]interface{}{
+			"name":       check.Name,
+			"expression": check.Expression,
+		})
+	}
+	err = d.Set("check", checks)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
+
+// RenamedColumns pairs the old columns missing from the new ones with the new columns missing from the old ones at
+// the same position, which are renamed instead of dropped and added back. Returns the new names by old name.
+func RenamedColumns(old, new []string) map[string]string {
+	renamed := make(map[string]string)
+	for i := 0; i < len(old) && i < len(new); i++ {
+		if indexOfName(new, old[i]) < 0 && indexOfName(old, new[i]) < 0 {
+			renamed[old[i]] = new[i]
+		}
+	}
+	return renamed
+}
+
+func indexOfName(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// ColumnNames lists the names of the columns in order
+func (t *Table) ColumnNames() []string {
+	names := make([]string, 0, len(t.Columns))
+	for _, column := range t.Columns {
+		names = append(names, column.Name)
+	}
+	return names
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TableTrigger is a DML trigger on a table or view, fired AFTER or INSTEAD OF the events
+type TableTrigger struct {
+	Database string
+	Schema   string
+	Table    string
+	Name     string
+	// Timing is AFTER or INSTEAD OF
+	Timing  string
+	Events  []string
+	Body    string
+	Enabled bool
+	// Order is FIRST or LAST when the trigger fires first or last among the AFTER triggers of all its events, NONE otherwise
+	Order string
+	// Definition is the CREATE TRIGGER statement read back from the server, empty when the trigger is encrypted
+	Definition string
+}
+
+func (t *TableTrigger) Parse(data *schema.ResourceData) *TableTrigger {
+	t.Database = data.Get("database").(string)
+	t.Schema = data.Get("schema").(string)
+	t.Table = data.Get("table").(string)
+	t.Name = data.Get("name").(string)
+	t.Timing = data.Get("timing").(string)
+	t.Events = make([]string, 0)
+	for _, event := range data.Get("events").(*schema.Set).List() {
+		t.Events = append(t.Events, event.(string))
+	}
+	t.Body = data.Get("body").(string)
+	t.Enabled = data.Get("enabled").(bool)
+	t.Order = data.Get("order").(string)
+	return t
+}
+
+func (t *TableTrigger) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", t.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", t.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("table", t.Table)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", t.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("timing", t.Timing)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("events", t.Events)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("body", t.Body)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("enabled", t.Enabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("order", t.Order)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("definition", t.Definition)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
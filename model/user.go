@@ -6,15 +6,17 @@ import (
 )
 
 type User struct {
-	PrincipalID int
-	Database    string
-	Username    string
-	ObjectId    string
-	LoginName   string
-	Password    string
-	AuthType    string
-	Options     OptionsList
-	Roles       []string
+	PrincipalID   int
+	Database      string
+	Username      string
+	ObjectId      string
+	IsGroup       bool
+	LoginName     string
+	Password      string
+	AuthType      string
+	DefaultSchema string
+	Options       OptionsList
+	Roles         []string
 }
 
 func (user *User) Parse(data *schema.ResourceData) *User {
@@ -26,9 +28,11 @@ func (user *User) Parse(data *schema.ResourceData) *User {
 	user.Database = data.Get("database").(string)
 	user.Username = data.Get("username").(string)
 	user.ObjectId = data.Get("object_id").(string)
+	user.IsGroup = data.Get("is_group").(bool)
 	user.LoginName = data.Get("login_name").(string)
 	user.Password = data.Get("password").(string)
 	user.AuthType = data.Get("auth_type").(string)
+	user.DefaultSchema = data.Get("default_schema").(string)
 	user.Options = make(OptionsList).Parse(data.Get("options").(map[string]interface{}))
 	user.Roles = nil
 	return user
@@ -41,6 +45,11 @@ func (user *User) ToSchema(d *schema.ResourceData) diag.Diagnostics {
 		diags = append(diags, diag.FromErr(err)[0])
 	}
 
+	err = d.Set("principal_id", user.PrincipalID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
 	err = d.Set("username", user.Username)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)[0])
@@ -56,6 +65,11 @@ func (user *User) ToSchema(d *schema.ResourceData) diag.Diagnostics {
 		diags = append(diags, diag.FromErr(err)[0])
 	}
 
+	err = d.Set("default_schema", user.DefaultSchema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
 	err = d.Set("options", user.Options)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)[0])
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type View struct {
+	Database      string
+	Schema        string
+	Name          string
+	Body          string
+	SchemaBinding bool
+	// Definition is the CREATE VIEW statement read back from the server, empty when the view is encrypted
+	Definition string
+}
+
+func (v *View) Parse(data *schema.ResourceData) *View {
+	v.Database = data.Get("database").(string)
+	v.Schema = data.Get("schema").(string)
+	v.Name = data.Get("name").(string)
+	v.Body = data.Get("body").(string)
+	v.SchemaBinding = data.Get("schemabinding").(bool)
+	return v
+}
+
+func (v *View) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("database", v.Database)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schema", v.Schema)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("name", v.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("body", v.Body)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("schemabinding", v.SchemaBinding)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("definition", v.Definition)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
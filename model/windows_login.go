@@ -0,0 +1,64 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Windows login types reported by type_desc of sys.server_principals
+const (
+	LoginTypeWindowsLogin = "WINDOWS_LOGIN"
+	LoginTypeWindowsGroup = "WINDOWS_GROUP"
+)
+
+type WindowsLogin struct {
+	Name            string
+	Sid             string
+	Type            string
+	DefaultDatabase string
+	DefaultLanguage string
+	Disabled        bool
+}
+
+func (login *WindowsLogin) Parse(data *schema.ResourceData) *WindowsLogin {
+	login.Name = data.Get("name").(string)
+	login.DefaultDatabase = data.Get("default_database").(string)
+	login.DefaultLanguage = data.Get("default_language").(string)
+	login.Disabled = data.Get("disabled").(bool)
+	return login
+}
+
+func (login *WindowsLogin) ToSchema(d *schema.ResourceData) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	err := d.Set("name", login.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("sid", login.Sid)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("type", login.Type)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_database", login.DefaultDatabase)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("default_language", login.DefaultLanguage)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	err = d.Set("disabled", login.Disabled)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+
+	return diags
+}
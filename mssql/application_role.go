@@ -0,0 +1,77 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetApplicationRole looks the application role up by principal ID, or by name when principalID is zero.
+// Returns nil when the role does not exist.
+func (c *Connector) GetApplicationRole(ctx context.Context, database string, principalID int, name string) (*model.ApplicationRole, error) {
+	stmtSQL := `SELECT principal_id, name, default_schema_name
+		FROM [sys].[database_principals]
+		WHERE type = 'A' AND `
+	if principalID != 0 {
+		stmtSQL += "principal_id = @principal_id"
+	} else {
+		stmtSQL += "[name] = @name"
+	}
+
+	var defaultSchema model.NullString
+	role := &model.ApplicationRole{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&role.PrincipalID, &role.Name, &defaultSchema)
+		}, sql.Named("principal_id", principalID), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	role.DefaultSchema = string(defaultSchema)
+	return role, nil
+}
+
+func (c *Connector) CreateApplicationRole(ctx context.Context, role *model.ApplicationRole) error {
+	with := []string{passwordOption(role.Password)}
+	if role.DefaultSchema != "" {
+		with = append(with, "DEFAULT_SCHEMA = "+quoteIdentifier(role.DefaultSchema))
+	}
+	stmtSQL := fmt.Sprintf("CREATE APPLICATION ROLE %s WITH %s", quoteIdentifier(role.Name), strings.Join(with, ", "))
+	return c.setDatabase(role.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterApplicationRole renames the role, sets its password or its default schema. Empty values are left unchanged.
+func (c *Connector) AlterApplicationRole(ctx context.Context, database string, name string, newName string, password string, defaultSchema string) error {
+	with := make([]string, 0)
+	if newName != "" && newName != name {
+		with = append(with, "NAME = "+quoteIdentifier(newName))
+	}
+	if password != "" {
+		with = append(with, passwordOption(password))
+	}
+	if defaultSchema != "" {
+		with = append(with, "DEFAULT_SCHEMA = "+quoteIdentifier(defaultSchema))
+	}
+	if len(with) == 0 {
+		return nil
+	}
+	stmtSQL := fmt.Sprintf("ALTER APPLICATION ROLE %s WITH %s", quoteIdentifier(name), strings.Join(with, ", "))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteApplicationRole(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[database_principals] WHERE type = 'A' AND [name] = @name) DROP APPLICATION ROLE %s",
+		quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+// passwordOption is the PASSWORD option of the statement, with the password as escaped string literal
+func passwordOption(password string) string {
+	return fmt.Sprintf("PASSWORD = '%s'", strings.ReplaceAll(password, "'", "''"))
+}
@@ -0,0 +1,118 @@
+package mssql
+
+import (
+	"context"
+	"crypto/sha512"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// assemblyVersion extracts the version from the strong name of an assembly, e.g. lib, version=1.2.0.0, culture=neutral
+var assemblyVersion = regexp.MustCompile(`(?i)\bversion=([\d.]+)`)
+
+// AssemblyHash is the SHA2_512 hash identifying the content in the trusted assemblies of the server
+func AssemblyHash(content []byte) string {
+	hash := sha512.Sum512(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// GetAssembly looks the assembly up by name, with the hash of its content and whether the server trusts it.
+// Returns nil when the assembly does not exist.
+func (c *Connector) GetAssembly(ctx context.Context, database, name string) (*model.Assembly, error) {
+	stmtSQL := `SELECT a.assembly_id, a.name, a.clr_name, a.permission_set_desc, COALESCE(p.name, ''),
+			HASHBYTES('SHA2_512', f.content),
+			CAST(IIF(EXISTS (SELECT 1 FROM [sys].[trusted_assemblies] t WHERE t.hash = HASHBYTES('SHA2_512', f.content)), 1, 0) AS bit)
+		FROM [sys].[assemblies] a
+			JOIN [sys].[assembly_files] f ON f.assembly_id = a.assembly_id AND f.file_id = 1
+			LEFT JOIN [sys].[database_principals] p ON p.principal_id = a.principal_id
+		WHERE a.name = @name AND a.is_user_defined = 1`
+
+	var hash []byte
+	assembly := &model.Assembly{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&assembly.AssemblyID, &assembly.Name, &assembly.ClrName, &assembly.PermissionSet,
+				&assembly.Owner, &hash, &assembly.Trusted)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// sys.assemblies reports SAFE_ACCESS and UNSAFE_ACCESS
+	if assembly.PermissionSet != "EXTERNAL_ACCESS" {
+		assembly.PermissionSet = strings.TrimSuffix(assembly.PermissionSet, "_ACCESS")
+	}
+	assembly.Hash = hex.EncodeToString(hash)
+	if match := assemblyVersion.FindStringSubmatch(assembly.ClrName); match != nil {
+		assembly.Version = match[1]
+	}
+	return assembly, nil
+}
+
+// CreateAssembly trusts the content of the assembly first when requested, as servers with CLR strict security
+// only load signed or trusted assemblies
+func (c *Connector) CreateAssembly(ctx context.Context, assembly *model.Assembly) error {
+	if assembly.Trusted {
+		if err := c.TrustAssembly(ctx, assembly.Database, assembly.Name, assembly.Content); err != nil {
+			return err
+		}
+	}
+
+	stmtSQL := fmt.Sprintf("CREATE ASSEMBLY %s", quoteIdentifier(assembly.Name))
+	if assembly.Owner != "" {
+		stmtSQL += " AUTHORIZATION " + quoteIdentifier(assembly.Owner)
+	}
+	stmtSQL += " FROM @content WITH PERMISSION_SET = " + assembly.PermissionSet
+	return c.setDatabase(assembly.Database).ExecContext(ctx, stmtSQL, sql.Named("content", assembly.Content))
+}
+
+// AlterAssembly loads the new version of the assembly, keeping the objects depending on it.
+// Without content, only the permission set is changed.
+func (c *Connector) AlterAssembly(ctx context.Context, assembly *model.Assembly) error {
+	stmtSQL := fmt.Sprintf("ALTER ASSEMBLY %s", quoteIdentifier(assembly.Name))
+	args := make([]interface{}, 0)
+	if assembly.Content != nil {
+		stmtSQL += " FROM @content"
+		args = append(args, sql.Named("content", assembly.Content))
+	}
+	stmtSQL += " WITH PERMISSION_SET = " + assembly.PermissionSet
+	return c.setDatabase(assembly.Database).ExecContext(ctx, stmtSQL, args...)
+}
+
+func (c *Connector) AlterAssemblyOwner(ctx context.Context, database, name, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON ASSEMBLY::%s TO %s", quoteIdentifier(name), quoteIdentifier(owner))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+// TrustAssembly adds the hash of the content to the trusted assemblies of the server, unless it is already trusted
+func (c *Connector) TrustAssembly(ctx context.Context, database, name string, content []byte) error {
+	stmtSQL := `DECLARE @hash varbinary(64) = HASHBYTES('SHA2_512', @content)
+		IF NOT EXISTS (SELECT 1 FROM [sys].[trusted_assemblies] WHERE hash = @hash)
+			EXEC sp_add_trusted_assembly @hash, @description`
+	description := fmt.Sprintf("%s.%s", quoteIdentifier(database), quoteIdentifier(name))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("content", content), sql.Named("description", description))
+}
+
+// DistrustAssembly removes the hash, in hex, from the trusted assemblies of the server
+func (c *Connector) DistrustAssembly(ctx context.Context, hash string) error {
+	value, err := hex.DecodeString(hash)
+	if err != nil {
+		return err
+	}
+	stmtSQL := `IF EXISTS (SELECT 1 FROM [sys].[trusted_assemblies] WHERE hash = @hash)
+		EXEC sp_drop_trusted_assembly @hash`
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("hash", value))
+}
+
+func (c *Connector) DeleteAssembly(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[assemblies] WHERE [name] = @name)
+		DROP ASSEMBLY %s`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,60 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetAsymmetricKey looks the key of the database up by name. Returns nil when the key does not exist.
+func (c *Connector) GetAsymmetricKey(ctx context.Context, database string, name string) (*model.AsymmetricKey, error) {
+	stmtSQL := `SELECT asymmetric_key_id, name, algorithm_desc, pvt_key_encryption_type_desc, CONVERT(varchar(64), thumbprint, 2)
+		FROM [sys].[asymmetric_keys] WHERE [name] = @name`
+
+	key := &model.AsymmetricKey{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&key.AsymmetricKeyID, &key.Name, &key.Algorithm, &key.PrivateKeyEncryption, &key.Thumbprint)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// CreateAsymmetricKey creates the key from the file, or generates it with the algorithm
+func (c *Connector) CreateAsymmetricKey(ctx context.Context, key *model.AsymmetricKey) error {
+	stmtSQL := fmt.Sprintf("CREATE ASYMMETRIC KEY %s", quoteIdentifier(key.Name))
+	if key.File != "" {
+		stmtSQL += " FROM FILE = " + quoteString(key.File)
+	} else {
+		stmtSQL += " WITH ALGORITHM = " + key.Algorithm
+	}
+	if key.Password != "" {
+		stmtSQL += " ENCRYPTION BY PASSWORD = " + quoteString(key.Password)
+	}
+	return c.setDatabase(key.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterAsymmetricKeyPrivateKey encrypts the private key by the new password, by the database master key when empty
+func (c *Connector) AlterAsymmetricKeyPrivateKey(ctx context.Context, database string, name string, oldPassword string, newPassword string) error {
+	options := privateKeyPasswordOptions(oldPassword, newPassword)
+	if len(options) == 0 {
+		return nil
+	}
+
+	stmtSQL := fmt.Sprintf("ALTER ASYMMETRIC KEY %s WITH PRIVATE KEY (%s)", quoteIdentifier(name), strings.Join(options, ", "))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteAsymmetricKey(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[asymmetric_keys] WHERE [name] = @name) DROP ASYMMETRIC KEY %s",
+		quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,55 @@
+package mssql
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+)
+
+// AWSSecret is an AWS Secrets Manager secret, read with the default AWS credential chain
+type AWSSecret struct {
+	// SecretID is the name or ARN of the secret
+	SecretID string
+	// Region defaults to AWS_REGION, or the region of the ARN
+	Region string
+	// Field of the JSON secret holding the password, e.g. "password" for RDS secrets. The whole secret string if empty.
+	Field string
+}
+
+func (a *AWSSecret) ReadSecret() (string, error) {
+	config := aws.Config{}
+	if a.Region != "" {
+		config.Region = aws.String(a.Region)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "reading AWS secret")
+	}
+
+	out, err := secretsmanager.New(sess).GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(a.SecretID),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "reading AWS secret %s", a.SecretID)
+	}
+	secret := aws.StringValue(out.SecretString)
+	if a.Field == "" {
+		return secret, nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", errors.Wrapf(err, "reading AWS secret %s: not a JSON object", a.SecretID)
+	}
+	value, ok := fields[a.Field].(string)
+	if !ok {
+		return "", errors.Errorf("reading AWS secret %s: no string field %q", a.SecretID, a.Field)
+	}
+	return value, nil
+}
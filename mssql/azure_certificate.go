@@ -0,0 +1,99 @@
+package mssql
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/pkg/errors"
+)
+
+// clientCertificate loads the certificate and RSA key of the service principal,
+// either from ClientCertificatePath or inline from ClientCertificate.
+// Both PEM (certificate and private key blocks) and PKCS#12 (PFX) are accepted; inline PFX is base64 encoded.
+func (l *AzureLogin) clientCertificate() (*x509.Certificate, *rsa.PrivateKey, error) {
+	var data []byte
+	if l.ClientCertificatePath != "" {
+		var err error
+		data, err = ioutil.ReadFile(l.ClientCertificatePath)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "reading client certificate")
+		}
+	} else if bytes.Contains([]byte(l.ClientCertificate), []byte("-----BEGIN")) {
+		data = []byte(l.ClientCertificate)
+	} else {
+		var err error
+		data, err = base64.StdEncoding.DecodeString(l.ClientCertificate)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "decoding client certificate, expected PEM or base64 encoded PFX")
+		}
+	}
+
+	if bytes.Contains(data, []byte("-----BEGIN")) {
+		return decodePemCertificate(data, l.ClientCertificatePassword)
+	}
+	cert, key, err := adal.DecodePfxCertificateData(data, l.ClientCertificatePassword)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "decoding PFX client certificate")
+	}
+	return cert, key, nil
+}
+
+func decodePemCertificate(data []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	var cert *x509.Certificate
+	var key *rsa.PrivateKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		der := block.Bytes
+		// legacy encrypted PEM keys (Proc-Type header) are still produced by openssl
+		if x509.IsEncryptedPEMBlock(block) {
+			var err error
+			if der, err = x509.DecryptPEMBlock(block, []byte(password)); err != nil {
+				return nil, nil, errors.Wrap(err, "decrypting client certificate private key")
+			}
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			if cert != nil {
+				// keep the leaf, the rest of the chain is not sent to Azure AD
+				continue
+			}
+			c, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "parsing client certificate")
+			}
+			cert = c
+		case "RSA PRIVATE KEY":
+			k, err := x509.ParsePKCS1PrivateKey(der)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "parsing client certificate private key")
+			}
+			key = k
+		case "PRIVATE KEY":
+			k, err := x509.ParsePKCS8PrivateKey(der)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "parsing client certificate private key")
+			}
+			rsaKey, ok := k.(*rsa.PrivateKey)
+			if !ok {
+				return nil, nil, errors.New("client certificate private key must be an RSA key")
+			}
+			key = rsaKey
+		}
+	}
+
+	if cert == nil || key == nil {
+		return nil, nil, errors.New("client certificate PEM must contain a CERTIFICATE and a PRIVATE KEY block")
+	}
+	return cert, key, nil
+}
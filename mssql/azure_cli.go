@@ -0,0 +1,59 @@
+package mssql
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// azureCLIToken is the subset of `az account get-access-token` output used by the provider
+type azureCLIToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresOn   string `json:"expiresOn"`
+	// ExpiresOnUnix is only returned by recent versions of the CLI
+	ExpiresOnUnix int64  `json:"expires_on"`
+	Tenant        string `json:"tenant"`
+	TokenType     string `json:"tokenType"`
+}
+
+// cliAccessToken asks the Azure CLI for a token of the signed-in account
+func cliAccessToken(resource string, tenantID string) (string, time.Time, error) {
+	args := []string{"account", "get-access-token", "--resource", resource, "--output", "json"}
+	if tenantID != "" {
+		args = append(args, "--tenant", tenantID)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("az", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", time.Time{}, errors.Wrapf(err, "error retrieving access token from Azure CLI: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	var token azureCLIToken
+	if err := json.Unmarshal(stdout.Bytes(), &token); err != nil {
+		return "", time.Time{}, errors.Wrap(err, "error retrieving access token from Azure CLI: unexpected output")
+	}
+	if token.AccessToken == "" {
+		return "", time.Time{}, errors.New("error retrieving access token from Azure CLI: empty token, run `az login` first")
+	}
+	return token.AccessToken, token.expires(), nil
+}
+
+// expires returns when the token expires, or the zero time (not cached) if it cannot be told
+func (t azureCLIToken) expires() time.Time {
+	if t.ExpiresOnUnix > 0 {
+		return time.Unix(t.ExpiresOnUnix, 0)
+	}
+	// older versions only return the local time
+	expires, err := time.ParseInLocation("2006-01-02 15:04:05.999999", t.ExpiresOn, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return expires
+}
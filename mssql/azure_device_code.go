@@ -0,0 +1,51 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/pkg/errors"
+)
+
+// deviceCodeToken signs the user in with the device code flow.
+// The resulting token is cached on the login and refreshed for the rest of the run.
+func (l *AzureLogin) deviceCodeToken(resource string) (*adal.ServicePrincipalToken, error) {
+	oauthConfig, err := l.oauthConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientID := firstNonEmpty(l.ClientID, sqlPublicClientID)
+
+	code, err := adal.InitiateDeviceAuth(http.DefaultClient, *oauthConfig, clientID, resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "error retrieving access token: starting device code sign-in")
+	}
+	promptDeviceCode(code)
+
+	token, err := adal.WaitForUserCompletion(http.DefaultClient, code)
+	if err != nil {
+		return nil, errors.Wrap(err, "error retrieving access token: waiting for device code sign-in")
+	}
+
+	return adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, clientID, resource, *token)
+}
+
+// promptDeviceCode shows the sign-in instructions. Terraform does not forward the provider
+// output to the user, so they are written to the controlling terminal when there is one.
+func promptDeviceCode(code *adal.DeviceCode) {
+	message := "To sign in to Azure SQL, open the device login page and enter the code shown by Azure AD"
+	if code.Message != nil {
+		message = *code.Message
+	}
+	log.Printf("[WARN] %s", message)
+
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		return
+	}
+	defer tty.Close()
+	fmt.Fprintf(tty, "\n%s\n\n", message)
+}
@@ -0,0 +1,268 @@
+package mssql
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+)
+
+// sqlPublicClientID is the public client application used by the SQL Server drivers
+// for Azure AD user authentication (ActiveDirectoryPassword, ActiveDirectoryInteractive)
+const sqlPublicClientID = "7f98cb04-cd1e-40df-9140-3bf7e2cea4db"
+
+// tokenMu guards the tokens cached on the logins, so that concurrent connections
+// share one acquisition (and one device code prompt) instead of each starting their own
+var tokenMu sync.Mutex
+
+// tokenCache keeps the credentials of a login for the rest of the run
+type tokenCache struct {
+	// spt refreshes itself when it is about to expire, see adal's RefreshWithin
+	spt *adal.ServicePrincipalToken
+	// cli is the last Azure CLI token and its expiry
+	cli        string
+	cliExpires time.Time
+	// chained is the credential that succeeded in the default credential chain
+	chained *AzureLogin
+}
+
+// tokenRefreshWithin is how long before expiry a cached token is renewed,
+// so that it does not expire in the middle of a connection
+const tokenRefreshWithin = 5 * time.Minute
+
+// accessToken acquires an Azure AD token for the resource with the configured credential,
+// reusing the one cached on the login while it is valid
+func (l *AzureLogin) accessToken(resource string) (string, error) {
+	tokenMu.Lock()
+	defer tokenMu.Unlock()
+	return l.cachedToken(resource)
+}
+
+func (l *AzureLogin) cachedToken(resource string) (string, error) {
+	if l.cache == nil {
+		l.cache = &tokenCache{}
+	}
+	cache := l.cache
+
+	if l.UseDefaultCredential {
+		if cache.chained != nil {
+			return cache.chained.cachedToken(resource)
+		}
+		return l.defaultCredentialToken(resource)
+	}
+
+	if l.UseCLI {
+		if cache.cli != "" && time.Until(cache.cliExpires) > tokenRefreshWithin {
+			return cache.cli, nil
+		}
+		token, expires, err := cliAccessToken(resource, l.TenantID)
+		if err != nil {
+			return "", err
+		}
+		cache.cli, cache.cliExpires = token, expires
+		return token, nil
+	}
+
+	if cache.spt == nil {
+		spt, err := l.servicePrincipalToken(resource)
+		if err != nil {
+			return "", err
+		}
+		spt.SetRefreshWithin(tokenRefreshWithin)
+		cache.spt = spt
+	}
+	token, err := freshToken(cache.spt)
+	if err != nil {
+		// start over on the next connection, e.g. with a new device code sign-in
+		cache.spt = nil
+		return "", err
+	}
+	return token, nil
+}
+
+func (l *AzureLogin) servicePrincipalToken(resource string) (*adal.ServicePrincipalToken, error) {
+	switch {
+	case l.UseMSI:
+		return managedIdentityToken(resource, l.ClientID)
+
+	case l.UseOIDC:
+		assertion, err := l.oidcAssertion()
+		if err != nil {
+			return nil, err
+		}
+		oauthConfig, err := l.oauthConfig()
+		if err != nil {
+			return nil, err
+		}
+		return adal.NewServicePrincipalTokenWithSecret(*oauthConfig, l.ClientID, resource, &federatedSecret{assertion: assertion})
+
+	case l.UseDeviceCode:
+		return l.deviceCodeToken(resource)
+
+	case l.Username != "":
+		oauthConfig, err := l.oauthConfig()
+		if err != nil {
+			return nil, err
+		}
+		return adal.NewServicePrincipalTokenFromUsernamePassword(*oauthConfig, firstNonEmpty(l.ClientID, sqlPublicClientID), l.Username, l.Password, resource)
+
+	case l.ClientCertificatePath != "" || l.ClientCertificate != "":
+		cert, key, err := l.clientCertificate()
+		if err != nil {
+			return nil, err
+		}
+		oauthConfig, err := l.oauthConfig()
+		if err != nil {
+			return nil, err
+		}
+		return adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, l.ClientID, cert, key, resource)
+
+	default:
+		oauthConfig, err := l.oauthConfig()
+		if err != nil {
+			return nil, err
+		}
+		return adal.NewServicePrincipalToken(*oauthConfig, l.ClientID, l.ClientSecret, resource)
+	}
+}
+
+func managedIdentityToken(resource string, clientID string) (*adal.ServicePrincipalToken, error) {
+	var options *adal.ManagedIdentityOptions
+	if clientID != "" {
+		// pick the user-assigned identity instead of the system-assigned one
+		options = &adal.ManagedIdentityOptions{ClientID: clientID}
+	}
+	return adal.NewServicePrincipalTokenFromManagedIdentity(resource, options)
+}
+
+func freshToken(spt *adal.ServicePrincipalToken) (string, error) {
+	if err := spt.EnsureFresh(); err != nil {
+		return "", err
+	}
+	return spt.OAuthToken(), nil
+}
+
+// defaultCredentialToken tries, in order, the service principal from the environment,
+// workload identity, managed identity and the Azure CLI, the same way DefaultAzureCredential does.
+// Values set in the azure_login block take precedence over the environment.
+func (l *AzureLogin) defaultCredentialToken(resource string) (string, error) {
+	tenantID := firstNonEmpty(l.TenantID, os.Getenv("AZURE_TENANT_ID"))
+	clientID := firstNonEmpty(l.ClientID, os.Getenv("AZURE_CLIENT_ID"))
+
+	var failures []string
+	fail := func(credential string, err error) {
+		failures = append(failures, credential+": "+err.Error())
+	}
+
+	if secret := firstNonEmpty(l.ClientSecret, os.Getenv("AZURE_CLIENT_SECRET")); secret != "" && tenantID != "" && clientID != "" {
+		login := l.withCredential(AzureLogin{TenantID: tenantID, ClientID: clientID, ClientSecret: secret})
+		if token, err := login.cachedToken(resource); err == nil {
+			l.cache.chained = login
+			return token, nil
+		} else {
+			fail("environment", err)
+		}
+	} else {
+		fail("environment", errors.New("AZURE_TENANT_ID, AZURE_CLIENT_ID and AZURE_CLIENT_SECRET are not set"))
+	}
+
+	if tokenFile := firstNonEmpty(l.OIDCTokenFilePath, os.Getenv("AZURE_FEDERATED_TOKEN_FILE")); tokenFile != "" && tenantID != "" && clientID != "" {
+		login := l.withCredential(AzureLogin{TenantID: tenantID, ClientID: clientID, UseOIDC: true, OIDCTokenFilePath: tokenFile})
+		if token, err := login.cachedToken(resource); err == nil {
+			l.cache.chained = login
+			return token, nil
+		} else {
+			fail("workload identity", err)
+		}
+	} else {
+		fail("workload identity", errors.New("AZURE_FEDERATED_TOKEN_FILE is not set"))
+	}
+
+	msi := l.withCredential(AzureLogin{ClientID: clientID, UseMSI: true})
+	if token, err := msi.cachedToken(resource); err != nil {
+		fail("managed identity", err)
+	} else {
+		l.cache.chained = msi
+		return token, nil
+	}
+
+	cli := l.withCredential(AzureLogin{TenantID: l.TenantID, UseCLI: true})
+	if token, err := cli.cachedToken(resource); err != nil {
+		fail("Azure CLI", err)
+	} else {
+		l.cache.chained = cli
+		return token, nil
+	}
+
+	return "", errors.Errorf("error retrieving access token: no credential in the chain succeeded:\n  %s", strings.Join(failures, "\n  "))
+}
+
+// withCredential returns the credential with the cloud settings of the receiver
+func (l *AzureLogin) withCredential(credential AzureLogin) *AzureLogin {
+	credential.Environment = l.Environment
+	credential.ActiveDirectoryEndpoint = l.ActiveDirectoryEndpoint
+	credential.Resource = l.Resource
+	return &credential
+}
+
+// environment resolves the Azure cloud from its short ("public", "usgovernment", "china", "german")
+// or full ("AzureUSGovernmentCloud") name
+func (l *AzureLogin) environment() (azure.Environment, error) {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '_' || r == '-' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, l.Environment)
+
+	switch name {
+	case "", "public", "azurepubliccloud":
+		return azure.PublicCloud, nil
+	case "usgovernment", "azureusgovernmentcloud":
+		return azure.USGovernmentCloud, nil
+	case "china", "azurechinacloud":
+		return azure.ChinaCloud, nil
+	case "german", "azuregermancloud":
+		return azure.GermanCloud, nil
+	}
+	return azure.EnvironmentFromName(l.Environment)
+}
+
+// TokenResource is the audience of the tokens presented to SQL
+func (l *AzureLogin) TokenResource() (string, error) {
+	if l.Resource != "" {
+		return l.Resource, nil
+	}
+	env, err := l.environment()
+	if err != nil {
+		return "", err
+	}
+	return env.ResourceIdentifiers.SQLDatabase, nil
+}
+
+func (l *AzureLogin) oauthConfig() (*adal.OAuthConfig, error) {
+	endpoint := l.ActiveDirectoryEndpoint
+	if endpoint == "" {
+		env, err := l.environment()
+		if err != nil {
+			return nil, err
+		}
+		endpoint = env.ActiveDirectoryEndpoint
+	}
+	// accounts signing in with their own credentials may omit the tenant
+	return adal.NewOAuthConfig(endpoint, firstNonEmpty(l.TenantID, "organizations"))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,103 @@
+package mssql
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/pkg/errors"
+)
+
+const (
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	// audience expected by Azure AD for tokens exchanged against a federated credential
+	federatedTokenAudience = "api://AzureADTokenExchange"
+)
+
+// federatedSecret authenticates the service principal with an externally issued
+// OIDC token (client assertion) instead of a client secret or certificate
+type federatedSecret struct {
+	assertion func() (string, error)
+}
+
+func (s *federatedSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := s.assertion()
+	if err != nil {
+		return err
+	}
+	v.Set("client_assertion_type", clientAssertionType)
+	v.Set("client_assertion", token)
+	return nil
+}
+
+// oidcAssertion returns the source of the federated token, in order of precedence:
+// the inline token, the token file (defaulting to the one projected by AKS workload identity)
+// and the GitHub Actions ID token endpoint
+func (l *AzureLogin) oidcAssertion() (func() (string, error), error) {
+	if l.OIDCToken != "" {
+		token := l.OIDCToken
+		return func() (string, error) { return token, nil }, nil
+	}
+
+	path := l.OIDCTokenFilePath
+	if path == "" {
+		path = os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+	}
+	if path != "" {
+		// the file is rotated by the kubelet, read it on every request
+		return func() (string, error) {
+			token, err := ioutil.ReadFile(path)
+			if err != nil {
+				return "", errors.Wrap(err, "error retrieving access token: reading federated token file")
+			}
+			return strings.TrimSpace(string(token)), nil
+		}, nil
+	}
+
+	requestURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestURL != "" && requestToken != "" {
+		return func() (string, error) { return githubIDToken(requestURL, requestToken) }, nil
+	}
+
+	return nil, errors.New("error retrieving access token: use_oidc is set but no OIDC token, token file or GitHub Actions ID token endpoint is available")
+}
+
+// githubIDToken requests an ID token for the Azure AD audience from the GitHub Actions runtime
+func githubIDToken(requestURL string, requestToken string) (string, error) {
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return "", errors.Wrap(err, "error retrieving access token: invalid ACTIONS_ID_TOKEN_REQUEST_URL")
+	}
+	query := u.Query()
+	query.Set("audience", federatedTokenAudience)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+requestToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "error retrieving access token: requesting GitHub Actions ID token")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("error retrieving access token: GitHub Actions ID token endpoint returned %s", resp.Status)
+	}
+
+	var body struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", errors.Wrap(err, "error retrieving access token: decoding GitHub Actions ID token")
+	}
+	return body.Value, nil
+}
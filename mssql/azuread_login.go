@@ -0,0 +1,67 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetAzureADLogin looks the Azure AD login or group up by SID, or by name when SID is empty.
+// Returns nil when the login does not exist.
+func (c *Connector) GetAzureADLogin(ctx context.Context, sid string, name string) (*model.AzureADLogin, error) {
+	// the SID of Azure AD principals is their object ID
+	stmtSQL := `SELECT name, type_desc, CONVERT(varchar(172), sid, 1), LOWER(CONVERT(nvarchar(36), CAST(sid AS uniqueidentifier))),
+			default_database_name, default_language_name, is_disabled
+		FROM [master].[sys].[server_principals]
+		WHERE type IN ('E', 'X') AND `
+	if sid != "" {
+		stmtSQL += "sid = CONVERT(varbinary(85), @sid, 1)"
+	} else {
+		stmtSQL += "[name] = @name"
+	}
+
+	var defaultDatabase, defaultLanguage model.NullString
+	login := &model.AzureADLogin{}
+	err := c.QueryRowContext(ctx, stmtSQL, func(r *sql.Row) error {
+		return r.Scan(&login.Name, &login.Type, &login.Sid, &login.ObjectId, &defaultDatabase, &defaultLanguage, &login.Disabled)
+	}, sql.Named("sid", sid), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	login.DefaultDatabase, login.DefaultLanguage = string(defaultDatabase), string(defaultLanguage)
+	return login, nil
+}
+
+// CreateAzureADLogin creates the login FROM EXTERNAL PROVIDER, on Managed Instance or in master of Azure SQL Database
+func (c *Connector) CreateAzureADLogin(ctx context.Context, login *model.AzureADLogin) error {
+	edition, err := c.GetEngineEdition(ctx)
+	if err != nil {
+		return err
+	}
+	if edition != EngineEditionAzureSQLDatabase && edition != EngineEditionAzureManagedInstance {
+		return fmt.Errorf("azure AD login %s: logins FROM EXTERNAL PROVIDER are supported by Azure SQL Database and Managed Instance only", login.Name)
+	}
+
+	stmtSQL := fmt.Sprintf("CREATE LOGIN %s FROM EXTERNAL PROVIDER", quoteIdentifier(login.Name))
+	options := loginDefaultOptions(login.DefaultDatabase, login.DefaultLanguage)
+	if login.ObjectId != "" {
+		// the server derives the SID from the object ID, instead of resolving the name with Microsoft Graph
+		options = append([]string{fmt.Sprintf("OBJECT_ID = '%s'", login.ObjectId)}, options...)
+	}
+	if len(options) > 0 {
+		stmtSQL += " WITH " + strings.Join(options, ", ")
+	}
+	if err := c.setDatabase("master").ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	if login.Disabled {
+		return c.SetLoginDisabled(ctx, login.Name, true)
+	}
+	return nil
+}
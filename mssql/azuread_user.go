@@ -0,0 +1,76 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetAzureADUser looks the Azure AD user or group up by principal ID, or by name when principalID is 0.
+// Returns nil when the user does not exist.
+func (c *Connector) GetAzureADUser(ctx context.Context, database string, principalID int, name string) (*model.AzureADUser, error) {
+	// the SID of Azure AD principals is their object ID, except for users of guest accounts
+	stmtSQL := `SELECT principal_id, name, type_desc, default_schema_name,
+			CASE WHEN DATALENGTH(sid) = 16 THEN LOWER(CONVERT(nvarchar(36), CAST(sid AS uniqueidentifier))) ELSE '' END
+		FROM [sys].[database_principals]
+		WHERE type IN ('E', 'X') AND `
+	if principalID != 0 {
+		stmtSQL += "principal_id = @principal_id"
+	} else {
+		stmtSQL += "[name] = @name"
+	}
+
+	var defaultSchema model.NullString
+	user := &model.AzureADUser{Database: database}
+	err := c.setDatabase(database).QueryRowContext(ctx, stmtSQL, func(r *sql.Row) error {
+		return r.Scan(&user.PrincipalID, &user.Name, &user.Type, &defaultSchema, &user.ObjectId)
+	}, sql.Named("principal_id", principalID), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	user.DefaultSchema = string(defaultSchema)
+	user.IsGroup = user.Type == model.UserTypeExternalGroup
+	return user, nil
+}
+
+// CreateAzureADUser creates the user FROM EXTERNAL PROVIDER, or with the SID derived from its object ID,
+// so that the server does not need to resolve the name with Microsoft Graph
+func (c *Connector) CreateAzureADUser(ctx context.Context, user *model.AzureADUser) error {
+	stmtSQL := "CREATE USER " + quoteIdentifier(user.Name)
+	with := make([]string, 0)
+	if user.ObjectId != "" {
+		sid, err := externalSidOption(user.ObjectId, user.IsGroup)
+		if err != nil {
+			return err
+		}
+		with = append(with, sid)
+	} else {
+		stmtSQL += " FROM EXTERNAL PROVIDER"
+	}
+	if user.DefaultSchema != "" {
+		with = append(with, "DEFAULT_SCHEMA = "+quoteIdentifier(user.DefaultSchema))
+	}
+	if len(with) > 0 {
+		stmtSQL += " WITH " + strings.Join(with, ", ")
+	}
+	return c.setDatabase(user.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterUserDefaultSchema sets the schema of the objects the user references without schema name
+func (c *Connector) AlterUserDefaultSchema(ctx context.Context, database string, name string, schema string) error {
+	stmtSQL := fmt.Sprintf("ALTER USER %s WITH DEFAULT_SCHEMA = %s", quoteIdentifier(name), quoteIdentifier(schema))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+// DropUser drops the user if it exists
+func (c *Connector) DropUser(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[database_principals] WHERE [name] = @name) DROP USER %s",
+		quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
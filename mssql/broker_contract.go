@@ -0,0 +1,76 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetBrokerContract looks the contract up by name, with its message types and owner.
+// Returns nil when the contract does not exist.
+func (c *Connector) GetBrokerContract(ctx context.Context, database, name string) (*model.BrokerContract, error) {
+	stmtSQL := `SELECT c.service_contract_id, c.name, COALESCE(p.name, '')
+		FROM [sys].[service_contracts] c
+			LEFT JOIN [sys].[database_principals] p ON p.principal_id = c.principal_id
+		WHERE c.name = @name`
+
+	contract := &model.BrokerContract{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&contract.ContractID, &contract.Name, &contract.Owner)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	contract.Messages = make([]model.BrokerContractMessage, 0)
+	err = connector.QueryContext(ctx, `SELECT m.name,
+			CASE WHEN u.is_sent_by_initiator = 1 AND u.is_sent_by_target = 1 THEN 'ANY'
+				WHEN u.is_sent_by_initiator = 1 THEN 'INITIATOR' ELSE 'TARGET' END
+		FROM [sys].[service_contract_message_usages] u
+			JOIN [sys].[service_message_types] m ON m.message_type_id = u.message_type_id
+		WHERE u.service_contract_id = @id`, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var message model.BrokerContractMessage
+			if err := rows.Scan(&message.MessageType, &message.SentBy); err != nil {
+				return err
+			}
+			contract.Messages = append(contract.Messages, message)
+		}
+		return rows.Err()
+	}, sql.Named("id", contract.ContractID))
+	if err != nil {
+		return nil, err
+	}
+	return contract, nil
+}
+
+func (c *Connector) CreateBrokerContract(ctx context.Context, contract *model.BrokerContract) error {
+	messages := make([]string, 0, len(contract.Messages))
+	for _, message := range contract.Messages {
+		messages = append(messages, fmt.Sprintf("%s SENT BY %s", quoteIdentifier(message.MessageType), message.SentBy))
+	}
+	stmtSQL := "CREATE CONTRACT " + quoteIdentifier(contract.Name)
+	if contract.Owner != "" {
+		stmtSQL += " AUTHORIZATION " + quoteIdentifier(contract.Owner)
+	}
+	stmtSQL += fmt.Sprintf(" (%s)", strings.Join(messages, ", "))
+	return c.setDatabase(contract.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) AlterBrokerContractOwner(ctx context.Context, database, name, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON CONTRACT::%s TO %s", quoteIdentifier(name), quoteIdentifier(owner))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteBrokerContract(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[service_contracts] WHERE [name] = @name)
+		DROP CONTRACT %s`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,69 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetBrokerMessageType looks the message type up by name, with its validation and owner.
+// Returns nil when the message type does not exist.
+func (c *Connector) GetBrokerMessageType(ctx context.Context, database, name string) (*model.BrokerMessageType, error) {
+	stmtSQL := `SELECT m.message_type_id, m.name,
+			CASE m.validation WHEN 'N' THEN 'NONE' WHEN 'E' THEN 'EMPTY'
+				ELSE IIF(m.xml_collection_id IS NULL, 'WELL_FORMED_XML', 'VALID_XML') END,
+			ISNULL(QUOTENAME(SCHEMA_NAME(x.schema_id)) + '.' + QUOTENAME(x.name), ''), COALESCE(p.name, '')
+		FROM [sys].[service_message_types] m
+			LEFT JOIN [sys].[xml_schema_collections] x ON x.xml_collection_id = m.xml_collection_id
+			LEFT JOIN [sys].[database_principals] p ON p.principal_id = m.principal_id
+		WHERE m.name = @name`
+
+	messageType := &model.BrokerMessageType{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&messageType.MessageTypeID, &messageType.Name, &messageType.Validation,
+				&messageType.XmlSchemaCollection, &messageType.Owner)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return messageType, nil
+}
+
+func (c *Connector) CreateBrokerMessageType(ctx context.Context, messageType *model.BrokerMessageType) error {
+	stmtSQL := "CREATE MESSAGE TYPE " + quoteIdentifier(messageType.Name)
+	if messageType.Owner != "" {
+		stmtSQL += " AUTHORIZATION " + quoteIdentifier(messageType.Owner)
+	}
+	stmtSQL += " VALIDATION = " + messageValidation(messageType)
+	return c.setDatabase(messageType.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) AlterBrokerMessageType(ctx context.Context, messageType *model.BrokerMessageType) error {
+	stmtSQL := fmt.Sprintf("ALTER MESSAGE TYPE %s VALIDATION = %s", quoteIdentifier(messageType.Name), messageValidation(messageType))
+	return c.setDatabase(messageType.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) AlterBrokerMessageTypeOwner(ctx context.Context, database, name, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON MESSAGE TYPE::%s TO %s", quoteIdentifier(name), quoteIdentifier(owner))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteBrokerMessageType(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[service_message_types] WHERE [name] = @name)
+		DROP MESSAGE TYPE %s`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+// messageValidation builds the VALIDATION clause value, the XML schema collection name being passed as is
+func messageValidation(messageType *model.BrokerMessageType) string {
+	if messageType.Validation == "VALID_XML" {
+		return "VALID_XML WITH SCHEMA COLLECTION " + messageType.XmlSchemaCollection
+	}
+	return messageType.Validation
+}
@@ -0,0 +1,83 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetBrokerQueue looks the queue up by schema and name, with its activation. Returns nil when the queue does not exist.
+func (c *Connector) GetBrokerQueue(ctx context.Context, database, schema, name string) (*model.BrokerQueue, error) {
+	stmtSQL := `SELECT q.object_id, SCHEMA_NAME(q.schema_id), q.name, q.is_receive_enabled, q.is_retention_enabled,
+			q.is_poison_message_handling_enabled, q.is_activation_enabled,
+			ISNULL(PARSENAME(q.activation_procedure, 2) + '.' + PARSENAME(q.activation_procedure, 1), ''), ISNULL(q.max_readers, 0),
+			CASE WHEN q.execute_as_principal_id = -2 THEN 'OWNER' ELSE ISNULL(USER_NAME(q.execute_as_principal_id), '') END
+		FROM [sys].[service_queues] q
+		WHERE q.schema_id = SCHEMA_ID(@schema) AND q.name = @name`
+
+	queue := &model.BrokerQueue{Database: database}
+	activation := &model.BrokerQueueActivation{}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&queue.ObjectID, &queue.Schema, &queue.Name, &queue.Enabled, &queue.Retention,
+				&queue.PoisonMessageHandling, &activation.Enabled, &activation.Procedure, &activation.MaxQueueReaders,
+				&activation.ExecuteAs)
+		}, sql.Named("schema", schema), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if activation.Procedure != "" {
+		queue.Activation = activation
+	}
+	return queue, nil
+}
+
+func (c *Connector) CreateBrokerQueue(ctx context.Context, queue *model.BrokerQueue) error {
+	stmtSQL := fmt.Sprintf("CREATE QUEUE %s.%s WITH %s", quoteIdentifier(queue.Schema), quoteIdentifier(queue.Name),
+		strings.Join(queueOptions(queue, false), ", "))
+	return c.setDatabase(queue.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterBrokerQueue sets all the options of the queue, dropping its activation when dropActivation is set
+// and the queue has none
+func (c *Connector) AlterBrokerQueue(ctx context.Context, queue *model.BrokerQueue, dropActivation bool) error {
+	stmtSQL := fmt.Sprintf("ALTER QUEUE %s.%s WITH %s", quoteIdentifier(queue.Schema), quoteIdentifier(queue.Name),
+		strings.Join(queueOptions(queue, dropActivation), ", "))
+	return c.setDatabase(queue.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteBrokerQueue(ctx context.Context, database, schema, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[service_queues] WHERE schema_id = SCHEMA_ID(@schema) AND [name] = @name)
+		DROP QUEUE %s.%s`, quoteIdentifier(schema), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("schema", schema), sql.Named("name", name))
+}
+
+func queueOptions(queue *model.BrokerQueue, dropActivation bool) []string {
+	options := []string{
+		"STATUS = " + onOff(queue.Enabled),
+		"RETENTION = " + onOff(queue.Retention),
+		fmt.Sprintf("POISON_MESSAGE_HANDLING (STATUS = %s)", onOff(queue.PoisonMessageHandling)),
+	}
+	if activation := queue.Activation; activation != nil {
+		options = append(options, fmt.Sprintf("ACTIVATION (STATUS = %s, PROCEDURE_NAME = %s, MAX_QUEUE_READERS = %d, EXECUTE AS %s)",
+			onOff(activation.Enabled), activation.Procedure, activation.MaxQueueReaders, queueExecuteAs(activation.ExecuteAs)))
+	} else if dropActivation {
+		options = append(options, "ACTIVATION (DROP)")
+	}
+	return options
+}
+
+// queueExecuteAs quotes the user name the activation procedure executes as, SELF and OWNER being keywords
+func queueExecuteAs(principal string) string {
+	switch strings.ToUpper(principal) {
+	case "SELF", "OWNER":
+		return strings.ToUpper(principal)
+	}
+	return quoteString(principal)
+}
@@ -0,0 +1,92 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetBrokerService looks the service up by name, with its queue and contracts. Returns nil when the service does not exist.
+func (c *Connector) GetBrokerService(ctx context.Context, database, name string) (*model.BrokerService, error) {
+	stmtSQL := `SELECT s.service_id, s.name, OBJECT_SCHEMA_NAME(s.service_queue_id), OBJECT_NAME(s.service_queue_id),
+			COALESCE(p.name, '')
+		FROM [sys].[services] s
+			LEFT JOIN [sys].[database_principals] p ON p.principal_id = s.principal_id
+		WHERE s.name = @name`
+
+	service := &model.BrokerService{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&service.ServiceID, &service.Name, &service.QueueSchema, &service.Queue, &service.Owner)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	service.Contracts, err = connector.queryStrings(ctx, `SELECT c.name
+		FROM [sys].[service_contract_usages] u
+			JOIN [sys].[service_contracts] c ON c.service_contract_id = u.service_contract_id
+		WHERE u.service_id = @id`, sql.Named("id", service.ServiceID))
+	if err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+func (c *Connector) CreateBrokerService(ctx context.Context, service *model.BrokerService) error {
+	stmtSQL := "CREATE SERVICE " + quoteIdentifier(service.Name)
+	if service.Owner != "" {
+		stmtSQL += " AUTHORIZATION " + quoteIdentifier(service.Owner)
+	}
+	stmtSQL += fmt.Sprintf(" ON QUEUE %s.%s", quoteIdentifier(service.QueueSchema), quoteIdentifier(service.Queue))
+	if len(service.Contracts) > 0 {
+		contracts := make([]string, 0, len(service.Contracts))
+		for _, contract := range service.Contracts {
+			contracts = append(contracts, quoteIdentifier(contract))
+		}
+		stmtSQL += fmt.Sprintf(" (%s)", strings.Join(contracts, ", "))
+	}
+	return c.setDatabase(service.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterBrokerService moves the service to its queue, and adds and drops contracts to match those of old.
+// Moving the service moves its messages to the new queue.
+func (c *Connector) AlterBrokerService(ctx context.Context, old, service *model.BrokerService) error {
+	stmtSQL := "ALTER SERVICE " + quoteIdentifier(service.Name)
+	if !strings.EqualFold(old.QueueSchema, service.QueueSchema) || !strings.EqualFold(old.Queue, service.Queue) {
+		stmtSQL += fmt.Sprintf(" ON QUEUE %s.%s", quoteIdentifier(service.QueueSchema), quoteIdentifier(service.Queue))
+	}
+
+	changes := make([]string, 0)
+	for _, contract := range service.Contracts {
+		if !containsString(old.Contracts, contract) {
+			changes = append(changes, "ADD CONTRACT "+quoteIdentifier(contract))
+		}
+	}
+	for _, contract := range old.Contracts {
+		if !containsString(service.Contracts, contract) {
+			changes = append(changes, "DROP CONTRACT "+quoteIdentifier(contract))
+		}
+	}
+	if len(changes) > 0 {
+		stmtSQL += fmt.Sprintf(" (%s)", strings.Join(changes, ", "))
+	}
+	return c.setDatabase(service.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) AlterBrokerServiceOwner(ctx context.Context, database, name, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON SERVICE::%s TO %s", quoteIdentifier(name), quoteIdentifier(owner))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteBrokerService(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[services] WHERE [name] = @name)
+		DROP SERVICE %s`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,119 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// certificateDate is the ISO 8601 format of the dates of certificates, which are in UTC
+const certificateDate = "2006-01-02T15:04:05"
+
+// GetCertificate looks the certificate of the database up by name. Returns nil when the certificate does not exist.
+func (c *Connector) GetCertificate(ctx context.Context, database string, name string) (*model.Certificate, error) {
+	stmtSQL := `SELECT certificate_id, name, subject, CONVERT(varchar(19), start_date, 126), CONVERT(varchar(19), expiry_date, 126),
+		pvt_key_encryption_type_desc, CONVERT(varchar(64), thumbprint, 2)
+		FROM [sys].[certificates] WHERE [name] = @name`
+
+	cert := &model.Certificate{Database: database}
+	var startDate, expiryDate string
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&cert.CertificateID, &cert.Name, &cert.Subject, &startDate, &expiryDate,
+				&cert.PrivateKeyEncryption, &cert.Thumbprint)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cert.StartDate, err = rfc3339Date(startDate); err != nil {
+		return nil, err
+	}
+	if cert.ExpiryDate, err = rfc3339Date(expiryDate); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// CreateCertificate creates the certificate from the backup files, or a self-signed certificate with the subject
+func (c *Connector) CreateCertificate(ctx context.Context, cert *model.Certificate) error {
+	var stmtSQL string
+	if cert.File != "" {
+		stmtSQL = fmt.Sprintf("CREATE CERTIFICATE %s FROM FILE = %s", quoteIdentifier(cert.Name), quoteString(cert.File))
+		if cert.PrivateKeyFile != "" {
+			options := []string{"FILE = " + quoteString(cert.PrivateKeyFile)}
+			if cert.PrivateKeyDecryptionPassword != "" {
+				options = append(options, "DECRYPTION BY PASSWORD = "+quoteString(cert.PrivateKeyDecryptionPassword))
+			}
+			if cert.PrivateKeyPassword != "" {
+				options = append(options, "ENCRYPTION BY PASSWORD = "+quoteString(cert.PrivateKeyPassword))
+			}
+			stmtSQL += fmt.Sprintf(" WITH PRIVATE KEY (%s)", strings.Join(options, ", "))
+		}
+	} else {
+		stmtSQL = fmt.Sprintf("CREATE CERTIFICATE %s", quoteIdentifier(cert.Name))
+		if cert.PrivateKeyPassword != "" {
+			stmtSQL += " ENCRYPTION BY PASSWORD = " + quoteString(cert.PrivateKeyPassword)
+		}
+		options := []string{"SUBJECT = " + quoteString(cert.Subject)}
+		dates := []struct{ option, date string }{{"START_DATE", cert.StartDate}, {"EXPIRY_DATE", cert.ExpiryDate}}
+		for _, d := range dates {
+			if d.date == "" {
+				continue
+			}
+			value, err := time.Parse(time.RFC3339, d.date)
+			if err != nil {
+				return err
+			}
+			options = append(options, fmt.Sprintf("%s = '%s'", d.option, value.UTC().Format(certificateDate)))
+		}
+		stmtSQL += " WITH " + strings.Join(options, ", ")
+	}
+
+	return c.setDatabase(cert.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterCertificatePrivateKey encrypts the private key by the new password, by the database master key when empty
+func (c *Connector) AlterCertificatePrivateKey(ctx context.Context, database string, name string, oldPassword string, newPassword string) error {
+	options := privateKeyPasswordOptions(oldPassword, newPassword)
+	if len(options) == 0 {
+		return nil
+	}
+
+	stmtSQL := fmt.Sprintf("ALTER CERTIFICATE %s WITH PRIVATE KEY (%s)", quoteIdentifier(name), strings.Join(options, ", "))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+// privateKeyPasswordOptions decrypt the private key with the old password, the database master key when empty,
+// and encrypt it by the new password, the database master key when empty
+func privateKeyPasswordOptions(oldPassword string, newPassword string) []string {
+	options := make([]string, 0, 2)
+	if oldPassword != "" {
+		options = append(options, "DECRYPTION BY PASSWORD = "+quoteString(oldPassword))
+	}
+	if newPassword != "" {
+		options = append(options, "ENCRYPTION BY PASSWORD = "+quoteString(newPassword))
+	}
+	return options
+}
+
+func (c *Connector) DeleteCertificate(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[certificates] WHERE [name] = @name) DROP CERTIFICATE %s",
+		quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+func rfc3339Date(date string) (string, error) {
+	value, err := time.Parse(certificateDate, date)
+	if err != nil {
+		return "", err
+	}
+	return value.Format(time.RFC3339), nil
+}
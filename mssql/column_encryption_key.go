@@ -0,0 +1,91 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetColumnEncryptionKey looks the Always Encrypted column encryption key up by name, with its encrypted values.
+// Returns nil when the key does not exist.
+func (c *Connector) GetColumnEncryptionKey(ctx context.Context, database string, name string) (*model.ColumnEncryptionKey, error) {
+	key := &model.ColumnEncryptionKey{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, "SELECT column_encryption_key_id, name FROM [sys].[column_encryption_keys] WHERE [name] = @name",
+		func(row *sql.Row) error {
+			return row.Scan(&key.ColumnEncryptionKeyID, &key.Name)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stmtSQL := `SELECT m.name, v.encryption_algorithm_name, CONVERT(varchar(max), v.encrypted_value, 1)
+		FROM [sys].[column_encryption_key_values] v
+		JOIN [sys].[column_master_keys] m ON m.column_master_key_id = v.column_master_key_id
+		WHERE v.column_encryption_key_id = @id`
+	err = connector.QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var value model.ColumnEncryptionKeyValue
+			if err := rows.Scan(&value.ColumnMasterKey, &value.Algorithm, &value.EncryptedValue); err != nil {
+				return err
+			}
+			key.Values = append(key.Values, value)
+		}
+		return rows.Err()
+	}, sql.Named("id", key.ColumnEncryptionKeyID))
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (c *Connector) CreateColumnEncryptionKey(ctx context.Context, key *model.ColumnEncryptionKey) error {
+	values := make([]string, 0, len(key.Values))
+	for _, value := range key.Values {
+		option, err := columnEncryptionKeyValue(value)
+		if err != nil {
+			return err
+		}
+		values = append(values, option)
+	}
+
+	stmtSQL := fmt.Sprintf("CREATE COLUMN ENCRYPTION KEY %s WITH VALUES %s", quoteIdentifier(key.Name), strings.Join(values, ", "))
+	return c.setDatabase(key.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AddColumnEncryptionKeyValue adds the value encrypted by another column master key, e.g. while rotating master keys
+func (c *Connector) AddColumnEncryptionKeyValue(ctx context.Context, database string, name string, value model.ColumnEncryptionKeyValue) error {
+	option, err := columnEncryptionKeyValue(value)
+	if err != nil {
+		return err
+	}
+	stmtSQL := fmt.Sprintf("ALTER COLUMN ENCRYPTION KEY %s ADD VALUE %s", quoteIdentifier(name), option)
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DropColumnEncryptionKeyValue(ctx context.Context, database string, name string, columnMasterKey string) error {
+	stmtSQL := fmt.Sprintf("ALTER COLUMN ENCRYPTION KEY %s DROP VALUE (COLUMN_MASTER_KEY = %s)",
+		quoteIdentifier(name), quoteIdentifier(columnMasterKey))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteColumnEncryptionKey(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[column_encryption_keys] WHERE [name] = @name) DROP COLUMN ENCRYPTION KEY %s",
+		quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+func columnEncryptionKeyValue(value model.ColumnEncryptionKeyValue) (string, error) {
+	if !BinaryLiteral.MatchString(value.EncryptedValue) {
+		return "", fmt.Errorf("invalid encrypted value of column master key '%s', expected 0x and uppercase hexadecimal digits",
+			value.ColumnMasterKey)
+	}
+	return fmt.Sprintf("(COLUMN_MASTER_KEY = %s, ALGORITHM = %s, ENCRYPTED_VALUE = %s)",
+		quoteIdentifier(value.ColumnMasterKey), quoteString(value.Algorithm), value.EncryptedValue), nil
+}
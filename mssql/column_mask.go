@@ -0,0 +1,55 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetColumnMask reads the masking function of the column, and the principals granted UNMASK on it.
+// Returns nil when the column is not masked.
+func (c *Connector) GetColumnMask(ctx context.Context, database string, schema string, table string, column string) (*model.ColumnMask, error) {
+	stmtSQL := `SELECT masking_function FROM [sys].[masked_columns]
+		WHERE object_id = OBJECT_ID(@table) AND name = @column AND is_masked = 1`
+
+	connector := c.setDatabase(database)
+	object := quoteIdentifier(schema) + "." + quoteIdentifier(table)
+	mask := &model.ColumnMask{Database: database, Schema: schema, Table: table, Column: column}
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&mask.Function)
+	}, sql.Named("table", object), sql.Named("column", column))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	mask.UnmaskPrincipals, err = connector.queryStrings(ctx, `SELECT p.name
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = dp.grantee_principal_id
+		WHERE dp.class = 1 AND dp.major_id = OBJECT_ID(@table) AND dp.minor_id = COLUMNPROPERTY(OBJECT_ID(@table), @column, 'ColumnId')
+			AND dp.permission_name = 'UNMASK' AND dp.state IN ('G', 'W')
+		ORDER BY p.name`, sql.Named("table", object), sql.Named("column", column))
+	if err != nil {
+		return nil, err
+	}
+	return mask, nil
+}
+
+// SetColumnMask adds the masking function to the column, or replaces the function of a masked column
+func (c *Connector) SetColumnMask(ctx context.Context, mask *model.ColumnMask) error {
+	stmtSQL := fmt.Sprintf("ALTER TABLE %s.%s ALTER COLUMN %s ADD MASKED WITH (FUNCTION = %s)",
+		quoteIdentifier(mask.Schema), quoteIdentifier(mask.Table), quoteIdentifier(mask.Column), quoteString(mask.Function))
+	return c.setDatabase(mask.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DropColumnMask(ctx context.Context, mask *model.ColumnMask) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[masked_columns] WHERE object_id = OBJECT_ID(@table) AND name = @column AND is_masked = 1)
+		ALTER TABLE %s.%s ALTER COLUMN %s DROP MASKED`,
+		quoteIdentifier(mask.Schema), quoteIdentifier(mask.Table), quoteIdentifier(mask.Column))
+	return c.setDatabase(mask.Database).ExecContext(ctx, stmtSQL,
+		sql.Named("table", quoteIdentifier(mask.Schema)+"."+quoteIdentifier(mask.Table)), sql.Named("column", mask.Column))
+}
@@ -0,0 +1,52 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// BinaryLiteral matches the varbinary literals, as CONVERT(varchar(max), value, 1) formats them
+var BinaryLiteral = regexp.MustCompile("^0x[0-9A-F]+$")
+
+// GetColumnMasterKey looks the Always Encrypted column master key up by name. Returns nil when the key does not exist.
+func (c *Connector) GetColumnMasterKey(ctx context.Context, database string, name string) (*model.ColumnMasterKey, error) {
+	stmtSQL := `SELECT column_master_key_id, name, key_store_provider_name, key_path, ISNULL(CONVERT(varchar(max), signature, 1), '')
+		FROM [sys].[column_master_keys] WHERE [name] = @name`
+
+	key := &model.ColumnMasterKey{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&key.ColumnMasterKeyID, &key.Name, &key.KeyStoreProviderName, &key.KeyPath, &key.Signature)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// CreateColumnMasterKey creates the metadata of the column master key, the key itself stays in the key store
+func (c *Connector) CreateColumnMasterKey(ctx context.Context, key *model.ColumnMasterKey) error {
+	options := fmt.Sprintf("KEY_STORE_PROVIDER_NAME = N%s, KEY_PATH = N%s", quoteString(key.KeyStoreProviderName), quoteString(key.KeyPath))
+	if key.Signature != "" {
+		if !BinaryLiteral.MatchString(key.Signature) {
+			return fmt.Errorf("invalid signature '%s', expected 0x and uppercase hexadecimal digits", key.Signature)
+		}
+		options += fmt.Sprintf(", ENCLAVE_COMPUTATIONS (SIGNATURE = %s)", key.Signature)
+	}
+
+	stmtSQL := fmt.Sprintf("CREATE COLUMN MASTER KEY %s WITH (%s)", quoteIdentifier(key.Name), options)
+	return c.setDatabase(key.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteColumnMasterKey(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[column_master_keys] WHERE [name] = @name) DROP COLUMN MASTER KEY %s",
+		quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,32 @@
+package mssql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/denisenkom/go-mssqldb/msdsn"
+)
+
+// ParseConnectionString parses ADO, ODBC (odbc:...) or URL (sqlserver://...) connection string
+// with the driver parser. Errors name the key holding the offending value when it can be found.
+func ParseConnectionString(dsn string) (msdsn.Config, error) {
+	config, params, err := msdsn.Parse(dsn)
+	if err == nil {
+		return config, nil
+	}
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		// Parse every key alone, quoted as ODBC braced value, to find the one the driver rejects
+		single := fmt.Sprintf("odbc:%s={%s}", key, strings.ReplaceAll(params[key], "}", "}}"))
+		if _, _, keyErr := msdsn.Parse(single); keyErr != nil {
+			return config, fmt.Errorf("connection string key '%s': %v", key, err)
+		}
+	}
+	return config, fmt.Errorf("connection string: %v", err)
+}
@@ -5,12 +5,11 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
-	"github.com/Azure/go-autorest/autorest/adal"
-	"github.com/Azure/go-autorest/autorest/azure"
 	mssql "github.com/denisenkom/go-mssqldb"
 	"github.com/pkg/errors"
-	"log"
+	"net"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -23,25 +22,128 @@ type Connector struct {
 	AzureLogin *AzureLogin
 	Timeout    time.Duration `json:"timeout,omitempty"`
 	Token      string
+	// MaxConnectAttempts bounds the connection retries within Timeout, unbounded if <= 0
+	MaxConnectAttempts int `json:"max_connect_attempts,omitempty"`
+	// DialTimeout bounds each TCP dial, StatementTimeout each statement or query, unbounded if 0
+	DialTimeout      time.Duration `json:"dial_timeout,omitempty"`
+	StatementTimeout time.Duration `json:"statement_timeout,omitempty"`
+	// DeadlockRetries is how many times a statement chosen as deadlock victim or hitting a lock timeout is run again
+	DeadlockRetries int `json:"deadlock_retries,omitempty"`
+	// Instance is a named instance, its port is resolved by the SQL Browser service and Port is ignored
+	Instance string `json:"instance,omitempty"`
+	// AppName and WorkstationID identify the sessions of the provider, e.g. in sys.dm_exec_sessions and audits
+	AppName       string `json:"app_name,omitempty"`
+	WorkstationID string `json:"workstation_id,omitempty"`
+	// ApplicationIntent is ReadWrite (default) or ReadOnly, to be routed to a readable secondary of an availability group
+	ApplicationIntent string `json:"application_intent,omitempty"`
+	// FailoverPartner is the mirror of a database mirroring pair, tried when Host is down
+	FailoverPartner     string `json:"failover_partner,omitempty"`
+	FailoverPartnerPort int    `json:"failover_partner_port,omitempty"`
+	// Tunnel, when set, routes the connections through an SSH bastion
+	Tunnel *SSHTunnel `json:"-"`
+	// Proxy, when set, routes the connections through a SOCKS5 or HTTP CONNECT proxy
+	Proxy *url.URL `json:"-"`
+	// TLS settings, passed as is to the driver
+	Encrypt                string `json:"encrypt,omitempty"`
+	TrustServerCertificate bool   `json:"trust_server_certificate,omitempty"`
+	Certificate            string `json:"certificate,omitempty"`
+	HostNameInCertificate  string `json:"hostname_in_certificate,omitempty"`
+	// IntegratedSecurity authenticates as the Windows account running Terraform (SSPI)
+	IntegratedSecurity bool `json:"integrated_security,omitempty"`
+	// AccessToken is an Azure AD token acquired outside of the provider, used as is
+	AccessToken string `json:"-"`
+	// TokenCommand, when set, prints the access token
+	TokenCommand *ExecTokenProvider `json:"-"`
+	// DSN is a raw connection string used instead of the one assembled from the fields above
+	DSN string `json:"-"`
+
+	// Settings of the pooled connections, see sql.DB
+	MaxOpenConns    int           `json:"max_open_conns,omitempty"`
+	MaxIdleConns    int           `json:"max_idle_conns,omitempty"`
+	ConnMaxLifetime time.Duration `json:"conn_max_lifetime,omitempty"`
+	ConnMaxIdleTime time.Duration `json:"conn_max_idle_time,omitempty"`
+	// KeepAlive is the interval of TCP keepalive probes, and of SSH keepalive requests through a tunnel,
+	// the driver default (30s) if 0
+	KeepAlive time.Duration `json:"keepalive,omitempty"`
+
+	pool *dbPool
 }
 
 type LoginUser struct {
 	Username string `json:"username,omitempty"`
 	Password string `json:"password,omitempty"`
+	// Domain of a Windows account, authenticated with NTLM
+	Domain string `json:"domain,omitempty"`
+	// PasswordSource, when set, provides Password and is read again when the login fails after a rotation
+	PasswordSource SecretSource `json:"-"`
+
+	rotating *rotatingSecret
 }
 
 type AzureLogin struct {
 	TenantID     string `json:"tenant_id,omitempty"`
 	ClientID     string `json:"client_id,omitempty"`
 	ClientSecret string `json:"client_secret,omitempty"`
+	// ClientCertificatePath or ClientCertificate (PEM or base64 PFX) authenticate the service principal instead of ClientSecret
+	ClientCertificatePath     string `json:"client_certificate_path,omitempty"`
+	ClientCertificate         string `json:"client_certificate,omitempty"`
+	ClientCertificatePassword string `json:"client_certificate_password,omitempty"`
+	// Username and Password of an Azure AD account, exchanged for a token (ActiveDirectoryPassword)
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// UseMSI acquires the token from managed identity endpoint (IMDS, App Service) instead of client secret.
+	// ClientID, when set, selects a user-assigned identity.
+	UseMSI bool `json:"use_msi,omitempty"`
+	// UseCLI takes the token of the account signed in with the Azure CLI
+	UseCLI bool `json:"use_cli,omitempty"`
+	// UseOIDC authenticates the service principal with a federated token (workload identity)
+	UseOIDC           bool   `json:"use_oidc,omitempty"`
+	OIDCToken         string `json:"oidc_token,omitempty"`
+	OIDCTokenFilePath string `json:"oidc_token_file_path,omitempty"`
+	// UseDefaultCredential tries environment, workload identity, managed identity and Azure CLI in turn
+	UseDefaultCredential bool `json:"use_default_credential,omitempty"`
+	// UseDeviceCode signs in interactively with the device code flow, once per run
+	UseDeviceCode bool `json:"use_device_code,omitempty"`
+	// Environment names the Azure cloud, ActiveDirectoryEndpoint and Resource override its endpoints
+	Environment             string `json:"environment,omitempty"`
+	ActiveDirectoryEndpoint string `json:"active_directory_endpoint,omitempty"`
+	Resource                string `json:"resource,omitempty"`
+
+	// cache is shared by the copies of the connector, see setDatabase
+	cache *tokenCache
 }
 
+// setDatabase returns a copy of the connector bound to the given database,
+// so that per-database statements do not leak into the shared provider connector
 func (c *Connector) setDatabase(database string) *Connector {
-	c.Database = database
+	c.sharedPool()
+	conn := *c
+	conn.Database = database
 	if database == "" {
-		c.Database = "master"
+		conn.Database = "master"
+	}
+	return &conn
+}
+
+// WithServer returns a copy of the connector bound to another server, authenticated with login
+// or with the credentials of the connector if nil. The copy shares the pool of the connector.
+func (c *Connector) WithServer(host string, port int, instance string, login *LoginUser) *Connector {
+	c.sharedPool()
+	conn := *c
+	conn.Host = host
+	conn.Port = port
+	conn.Instance = instance
+	// settings naming the original server
+	conn.FailoverPartner = ""
+	conn.FailoverPartnerPort = 0
+	conn.HostNameInCertificate = ""
+	if login != nil {
+		conn.Login = login
+		conn.AzureLogin = nil
+		conn.AccessToken = ""
+		conn.IntegratedSecurity = false
 	}
-	return c
+	return &conn
 }
 
 func (c *Connector) PingContext(ctx context.Context) error {
@@ -64,14 +166,16 @@ func (c *Connector) ExecContext(ctx context.Context, command string, args ...int
 	if err != nil {
 		return err
 	}
-	defer db.Close()
+	ctx, cancel := c.statementContext(ctx)
+	defer cancel()
 
-	_, err = db.ExecContext(ctx, command, args...)
-	if err != nil {
+	done := c.traceStatement("statement", command, args)
+	err = c.connectBackoff().run("statement", func() error {
+		_, err := db.ExecContext(ctx, command, args...)
 		return err
-	}
-
-	return nil
+	}, c.statementRetryable())
+	done(err)
+	return err
 }
 
 func (c *Connector) QueryContext(ctx context.Context, query string, scanner func(*sql.Rows) error, args ...interface{}) error {
@@ -79,9 +183,18 @@ func (c *Connector) QueryContext(ctx context.Context, query string, scanner func
 	if err != nil {
 		return err
 	}
-	defer db.Close()
-
-	rows, err := db.QueryContext(ctx, query, args...)
+	ctx, cancel := c.statementContext(ctx)
+	defer cancel()
+
+	// only the query is retried, the scanner may already have consumed rows
+	var rows *sql.Rows
+	done := c.traceStatement("query", query, args)
+	err = c.connectBackoff().run("query", func() error {
+		var err error
+		rows, err = db.QueryContext(ctx, query, args...)
+		return err
+	}, c.statementRetryable())
+	done(err)
 	if err != nil {
 		return err
 	}
@@ -95,37 +208,127 @@ func (c *Connector) QueryRowContext(ctx context.Context, query string, scanner f
 	if err != nil {
 		return err
 	}
-	defer db.Close()
+	ctx, cancel := c.statementContext(ctx)
+	defer cancel()
 
-	row := db.QueryRowContext(ctx, query, args...)
-	if row.Err() != nil {
+	var row *sql.Row
+	done := c.traceStatement("query", query, args)
+	err = c.connectBackoff().run("query", func() error {
+		row = db.QueryRowContext(ctx, query, args...)
 		return row.Err()
+	}, c.statementRetryable())
+	done(err)
+	if err != nil {
+		return err
 	}
 
 	return scanner(row)
 }
 
+// statementContext bounds one statement or query, including its retries, by StatementTimeout
+func (c *Connector) statementContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.StatementTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.StatementTimeout)
+}
+
+// queryStrings collects the first column of every row returned by the query
+func (c *Connector) queryStrings(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	values := make([]string, 0)
+	err := c.QueryContext(ctx, query, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var value string
+			if err := rows.Scan(&value); err != nil {
+				return err
+			}
+			values = append(values, value)
+		}
+		return rows.Err()
+	}, args...)
+	return values, err
+}
+
 func (c *Connector) db() (*sql.DB, error) {
 	if c == nil {
 		panic("No connector")
 	}
-	conn, err := c.connector()
+	return c.sharedPool().get(c.poolKey(), func() (*sql.DB, error) {
+		conn, err := c.connector()
+		if err != nil {
+			return nil, err
+		}
+		db, err := connectLoop(checkedConnector{Connector: conn, pool: c.sharedPool()}, c.connectBackoff())
+		if err != nil {
+			return nil, err
+		}
+		db.SetMaxOpenConns(c.MaxOpenConns)
+		db.SetMaxIdleConns(c.MaxIdleConns)
+		db.SetConnMaxLifetime(c.ConnMaxLifetime)
+		db.SetConnMaxIdleTime(c.ConnMaxIdleTime)
+		return db, nil
+	})
+}
+
+func (c *Connector) connector() (driver.Connector, error) {
+	conn, err := c.driverConnector()
 	if err != nil {
 		return nil, err
 	}
-	if db, err := connectLoop(conn, c.Timeout); err != nil {
-		return nil, err
-	} else {
-		return db, nil
+	// the rotating connector sets the dialer of the connectors it creates
+	if dialer := c.dialer(); dialer != nil {
+		if mc, ok := conn.(*mssql.Connector); ok {
+			mc.Dialer = dialer
+		}
 	}
+	return conn, nil
 }
 
-func (c *Connector) connector() (driver.Connector, error) {
+// dialer returns the dialer reaching the server through a tunnel or a proxy, nil to dial it directly
+func (c *Connector) dialer() mssql.Dialer {
+	target := net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+	if c.Tunnel != nil {
+		return tunnelDialer{tunnel: c.Tunnel, target: target, keepAlive: c.KeepAlive}
+	}
+	if c.Proxy != nil {
+		return proxyDialer{proxy: c.Proxy, target: target, keepAlive: c.KeepAlive}
+	}
+	return nil
+}
+
+func (c *Connector) driverConnector() (driver.Connector, error) {
+	if c.DSN != "" {
+		config, err := ParseConnectionString(c.DSN)
+		if err != nil {
+			return nil, err
+		}
+		config.Database = c.Database
+		// differ from the connection string for the server blocks of the resources
+		config.Host = c.Host
+		config.Port = uint64(c.Port)
+		config.Instance = c.Instance
+		if c.Login != nil {
+			config.User = c.Login.Username
+			config.Password = c.Login.Password
+		}
+		return mssql.NewConnectorConfig(config), nil
+	}
+
+	if c.Login != nil && c.Login.PasswordSource != nil && !c.IntegratedSecurity {
+		return &rotatingConnector{c: c}, nil
+	}
+
 	connectionString := c.ConnectionString()
-	if c.Login != nil {
+	if c.Login != nil || c.IntegratedSecurity {
 		return mssql.NewConnector(connectionString)
 	}
-	return mssql.NewAccessTokenConnector(connectionString, func() (string, error) { return c.tokenProvider() })
+	return mssql.NewAccessTokenConnector(connectionString, func() (string, error) {
+		token, err := c.tokenProvider()
+		if err != nil {
+			return "", &tokenError{err: err}
+		}
+		return token, nil
+	})
 }
 
 func (c *Connector) ConnectionString() string {
@@ -133,72 +336,104 @@ func (c *Connector) ConnectionString() string {
 	if c.Database != "" {
 		query.Set("database", c.Database)
 	}
-	return (&url.URL{
+	if c.DialTimeout > 0 {
+		query.Set("dial timeout", strconv.Itoa(int(c.DialTimeout.Seconds())))
+	}
+	if c.KeepAlive > 0 {
+		query.Set("keepalive", strconv.Itoa(int(c.KeepAlive.Seconds())))
+	}
+	if c.AppName != "" {
+		query.Set("app name", c.AppName)
+	}
+	if c.WorkstationID != "" {
+		query.Set("workstation id", c.WorkstationID)
+	}
+	if c.ApplicationIntent != "" {
+		query.Set("applicationintent", c.ApplicationIntent)
+	}
+	if c.FailoverPartner != "" {
+		query.Set("failoverpartner", c.FailoverPartner)
+		if c.FailoverPartnerPort != 0 {
+			query.Set("failoverport", strconv.Itoa(c.FailoverPartnerPort))
+		}
+	}
+	if c.Encrypt != "" {
+		query.Set("encrypt", c.Encrypt)
+	}
+	if c.TrustServerCertificate {
+		query.Set("trustservercertificate", "true")
+	}
+	if c.Certificate != "" {
+		query.Set("certificate", c.Certificate)
+	}
+	if c.HostNameInCertificate != "" {
+		query.Set("hostnameincertificate", c.HostNameInCertificate)
+	}
+	host := c.Host
+	if c.Tunnel != nil || c.Proxy != nil {
+		// the tunnel or proxy resolves Host on the remote side, the certificate is still checked against it
+		host = tunnelLocalHost
+		if c.HostNameInCertificate == "" {
+			query.Set("hostnameincertificate", c.Host)
+		}
+	}
+	u := &url.URL{
 		Scheme:   "sqlserver",
 		User:     c.userPassword(),
-		Host:     fmt.Sprintf("%s:%d", c.Host, c.Port),
+		Host:     fmt.Sprintf("%s:%d", host, c.Port),
 		RawQuery: query.Encode(),
-	}).String()
+	}
+	if c.Instance != "" {
+		u.Host = host
+		u.Path = c.Instance
+	}
+	return u.String()
 }
 
 func (c *Connector) userPassword() *url.Userinfo {
-	if c.Login != nil {
-		return url.UserPassword(c.Login.Username, c.Login.Password)
+	// without user, the driver negotiates SSPI with the credentials of the current process
+	if c.Login != nil && !c.IntegratedSecurity {
+		username := c.Login.Username
+		if c.Login.Domain != "" {
+			// the driver switches to NTLM for DOMAIN\user names
+			username = c.Login.Domain + `\` + username
+		}
+		return url.UserPassword(username, c.Login.Password)
 	}
 	return nil
 }
 
 func (c *Connector) tokenProvider() (string, error) {
-	const resourceID = "https://database.windows.net/"
-
-	admin := c.AzureLogin
-	oauthConfig, err := adal.NewOAuthConfig(azure.PublicCloud.ActiveDirectoryEndpoint, admin.TenantID)
-	if err != nil {
-		return "", err
+	if c.AccessToken != "" {
+		return c.AccessToken, nil
+	}
+	if c.TokenCommand != nil {
+		return c.TokenCommand.Token()
 	}
 
-	spt, err := adal.NewServicePrincipalToken(*oauthConfig, admin.ClientID, admin.ClientSecret, resourceID)
+	resourceID, err := c.AzureLogin.TokenResource()
 	if err != nil {
 		return "", err
 	}
 
-	err = spt.EnsureFresh()
+	token, err := c.AzureLogin.accessToken(resourceID)
 	if err != nil {
 		return "", err
 	}
 
-	c.Token = spt.OAuthToken()
+	c.Token = token
 
-	return spt.OAuthToken(), nil
+	return token, nil
 }
 
-func connectLoop(connector driver.Connector, timeout time.Duration) (*sql.DB, error) {
-	ticker := time.NewTicker(250 * time.Millisecond)
-	defer ticker.Stop()
-
-	timeoutExceeded := time.After(timeout)
-	for {
-		select {
-		case <-timeoutExceeded:
-			return nil, fmt.Errorf("db connection failed after %s timeout", timeout)
-
-		case <-ticker.C:
-			db, err := connect(connector)
-			if err == nil {
-				return db, nil
-			}
-			if strings.Contains(err.Error(), "Login failed") {
-				return nil, err
-			}
-			if strings.Contains(err.Error(), "Login error") {
-				return nil, err
-			}
-			if strings.Contains(err.Error(), "error retrieving access token") {
-				return nil, err
-			}
-			log.Println(errors.Wrap(err, "failed to connect to database"))
-		}
-	}
+func connectLoop(connector driver.Connector, policy backoff) (*sql.DB, error) {
+	var db *sql.DB
+	err := policy.run("db connection", func() error {
+		var err error
+		db, err = connect(connector)
+		return err
+	}, isRetryableConnectError)
+	return db, err
 }
 
 func connect(connector driver.Connector) (*sql.DB, error) {
@@ -210,6 +445,12 @@ func connect(connector driver.Connector) (*sql.DB, error) {
 	return db, nil
 }
 
+// quoteIdentifier quotes the name as QUOTENAME() does, so it can be used in DDL statements
 func quoteIdentifier(id string) string {
-	return id
+	return "[" + strings.ReplaceAll(id, "]", "]]") + "]"
+}
+
+// quoteString quotes the value as string literal, for the statements not accepting parameters
+func quoteString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
 }
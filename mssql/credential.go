@@ -0,0 +1,50 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetCredential looks the server credential up by name. Returns nil when the credential does not exist.
+func (c *Connector) GetCredential(ctx context.Context, name string) (*model.Credential, error) {
+	stmtSQL := "SELECT credential_id, name, credential_identity FROM [master].[sys].[credentials] WHERE [name] = @name"
+
+	credential := &model.Credential{}
+	err := c.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&credential.CredentialID, &credential.Name, &credential.Identity)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+func (c *Connector) CreateCredential(ctx context.Context, credential *model.Credential) error {
+	stmtSQL := fmt.Sprintf("CREATE CREDENTIAL %s WITH %s", quoteIdentifier(credential.Name), credentialOptions(credential))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+// AlterCredential sets the identity and the secret of the credential. An empty secret clears it.
+func (c *Connector) AlterCredential(ctx context.Context, credential *model.Credential) error {
+	stmtSQL := fmt.Sprintf("ALTER CREDENTIAL %s WITH %s", quoteIdentifier(credential.Name), credentialOptions(credential))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteCredential(ctx context.Context, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[credentials] WHERE [name] = @name) DROP CREDENTIAL %s", quoteIdentifier(name))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+func credentialOptions(credential *model.Credential) string {
+	options := "IDENTITY = " + quoteString(credential.Identity)
+	if credential.Secret != "" {
+		options += ", SECRET = " + quoteString(credential.Secret)
+	}
+	return options
+}
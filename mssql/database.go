@@ -0,0 +1,186 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// databaseOperationInterval is the delay between two polls of the asynchronous operations of Azure SQL Database
+const databaseOperationInterval = 10 * time.Second
+
+// GetDatabase looks the database up by name, with its collation, compatibility level, containment and owner,
+// and its edition options in Azure SQL Database. Returns nil when the database does not exist.
+func (c *Connector) GetDatabase(ctx context.Context, name string) (*model.Database, error) {
+	stmtSQL := `SELECT name, ISNULL(collation_name, ''), compatibility_level, containment_desc, ISNULL(SUSER_SNAME(owner_sid), '')
+		FROM [sys].[databases] WHERE name = @name`
+
+	database := &model.Database{Options: make(model.OptionsList)}
+	connector := c.setDatabase("master")
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&database.Name, &database.DefaultCollation, &database.CompatibilityLevel,
+			&database.Containment, &database.Owner)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	edition, err := connector.GetEngineEdition(ctx)
+	if err != nil || edition != EngineEditionAzureSQLDatabase {
+		return database, err
+	}
+	stmtSQL = `SELECT so.service_objective, ISNULL(so.elastic_pool_name, ''),
+			CAST(ISNULL(DATABASEPROPERTYEX(d.name, 'MaxSizeInBytes'), 0) AS bigint) / 1073741824
+		FROM [sys].[databases] d
+			JOIN [sys].[database_service_objectives] so ON so.database_id = d.database_id
+		WHERE d.name = @name`
+	err = connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&database.ServiceObjective, &database.ElasticPool, &database.MaxSizeGB)
+	}, sql.Named("name", name))
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	return database, nil
+}
+
+// CreateDatabase creates the database, then sets its compatibility level and owner when given
+func (c *Connector) CreateDatabase(ctx context.Context, database *model.Database) error {
+	stmtSQL := "CREATE DATABASE " + quoteIdentifier(database.Name)
+	if database.Containment == "PARTIAL" {
+		stmtSQL += " CONTAINMENT = PARTIAL"
+	}
+	if database.DefaultCollation != "" {
+		stmtSQL += " COLLATE " + database.DefaultCollation
+	}
+	editionOptions := databaseEditionOptions(database)
+	if len(editionOptions) > 0 {
+		stmtSQL += " (" + strings.Join(editionOptions, ", ") + ")"
+	}
+	if len(database.Options) > 0 {
+		options := make([]string, 0, len(database.Options))
+		for opt := range database.Options {
+			options = append(options, fmt.Sprintf("%s = %s", opt, database.Options[opt].ValueOrSqlNull()))
+		}
+		stmtSQL += " WITH " + strings.Join(options, ", ")
+	}
+
+	connector := c.setDatabase("master")
+	if err := connector.ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	if len(editionOptions) > 0 {
+		if err := connector.WaitDatabaseOperation(ctx, database.Name); err != nil {
+			return err
+		}
+	}
+	if database.CompatibilityLevel > 0 {
+		if err := connector.SetDatabaseCompatibilityLevel(ctx, database.Name, database.CompatibilityLevel); err != nil {
+			return err
+		}
+	}
+	if database.Owner != "" {
+		return connector.SetDatabaseOwner(ctx, database.Name, database.Owner)
+	}
+	return nil
+}
+
+// ModifyDatabase changes the edition options set in database, i.e. moves it to the service objective or into the elastic
+// pool, or changes its maximum size, then waits for the scaling to complete
+func (c *Connector) ModifyDatabase(ctx context.Context, database *model.Database) error {
+	editionOptions := databaseEditionOptions(database)
+	if len(editionOptions) == 0 {
+		return nil
+	}
+
+	connector := c.setDatabase("master")
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s MODIFY (%s)", quoteIdentifier(database.Name), strings.Join(editionOptions, ", "))
+	if err := connector.ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	return connector.WaitDatabaseOperation(ctx, database.Name)
+}
+
+// databaseEditionOptions lists the Azure SQL Database edition options set in database, the elastic pool prevailing
+// over the service objective
+func databaseEditionOptions(database *model.Database) []string {
+	options := make([]string, 0)
+	if database.MaxSizeGB > 0 {
+		options = append(options, fmt.Sprintf("MAXSIZE = %d GB", database.MaxSizeGB))
+	}
+	if database.ElasticPool != "" {
+		options = append(options, fmt.Sprintf("SERVICE_OBJECTIVE = ELASTIC_POOL(name = %s)", quoteIdentifier(database.ElasticPool)))
+	} else if database.ServiceObjective != "" {
+		options = append(options, "SERVICE_OBJECTIVE = "+quoteString(database.ServiceObjective))
+	}
+	return options
+}
+
+// WaitDatabaseOperation waits for the last operation of the database listed in sys.dm_operation_status to complete,
+// and fails when it did not succeed
+func (c *Connector) WaitDatabaseOperation(ctx context.Context, database string) error {
+	stmtSQL := `SELECT TOP 1 operation, state, state_desc, percent_complete, ISNULL(error_desc, '')
+		FROM [sys].[dm_operation_status]
+		WHERE major_resource_id = @database
+		ORDER BY start_time DESC`
+
+	for {
+		var operation, stateDesc, errorDesc string
+		var state, percent int
+		err := c.setDatabase("master").
+			QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+				return row.Scan(&operation, &state, &stateDesc, &percent, &errorDesc)
+			}, sql.Named("database", database))
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch state {
+		case 2:
+			return nil
+		case 3, 4:
+			return fmt.Errorf("%s of database %s %s: %s", operation, database, strings.ToLower(stateDesc), errorDesc)
+		}
+
+		log.Printf("[INFO] Database %s: %s %s, %d%% complete", database, operation, stateDesc, percent)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s of database %s still %s: %s", operation, database, stateDesc, ctx.Err())
+		case <-time.After(databaseOperationInterval):
+		}
+	}
+}
+
+func (c *Connector) SetDatabaseCollation(ctx context.Context, name, collation string) error {
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s COLLATE %s", quoteIdentifier(name), collation)
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) SetDatabaseCompatibilityLevel(ctx context.Context, name string, level int) error {
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s SET COMPATIBILITY_LEVEL = %d", quoteIdentifier(name), level)
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) SetDatabaseContainment(ctx context.Context, name, containment string) error {
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s SET CONTAINMENT = %s", quoteIdentifier(name), containment)
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) SetDatabaseOwner(ctx context.Context, name, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON DATABASE::%s TO %s", quoteIdentifier(name), quoteIdentifier(owner))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DropDatabase(ctx context.Context, name string) error {
+	stmtSQL := fmt.Sprintf("IF DB_ID(@name) IS NOT NULL DROP DATABASE %s", quoteIdentifier(name))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,131 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabaseAuditSpecification looks the specification up by name, with its audit, action groups and actions.
+// Returns nil when the specification does not exist.
+func (c *Connector) GetDatabaseAuditSpecification(ctx context.Context, database string, name string) (*model.DatabaseAuditSpecification, error) {
+	stmtSQL := `SELECT s.database_specification_id, s.name, ISNULL(a.name, ''), s.is_state_enabled
+		FROM [sys].[database_audit_specifications] s
+			LEFT JOIN [sys].[server_audits] a ON a.audit_guid = s.audit_guid
+		WHERE s.name = @name`
+
+	var id int
+	spec := &model.DatabaseAuditSpecification{Database: database, ActionGroups: make([]string, 0), Actions: make([]model.AuditAction, 0)}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&id, &spec.Name, &spec.Audit, &spec.Enabled)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stmtSQL = `SELECT audit_action_name, is_group,
+			CASE class WHEN 1 THEN 'OBJECT' WHEN 3 THEN 'SCHEMA' ELSE 'DATABASE' END,
+			ISNULL(CASE class WHEN 1 THEN OBJECT_SCHEMA_NAME(major_id) WHEN 3 THEN SCHEMA_NAME(major_id) END, ''),
+			ISNULL(CASE class WHEN 1 THEN OBJECT_NAME(major_id) END, ''),
+			ISNULL(USER_NAME(audited_principal_id), '')
+		FROM [sys].[database_audit_specification_details]
+		WHERE database_specification_id = @id`
+	err = connector.QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var action model.AuditAction
+			var isGroup bool
+			if err := rows.Scan(&action.Action, &isGroup, &action.Class, &action.Schema, &action.Object, &action.Principal); err != nil {
+				return err
+			}
+			if isGroup {
+				spec.ActionGroups = append(spec.ActionGroups, action.Action)
+			} else {
+				spec.Actions = append(spec.Actions, action)
+			}
+		}
+		return rows.Err()
+	}, sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func (c *Connector) CreateDatabaseAuditSpecification(ctx context.Context, spec *model.DatabaseAuditSpecification) error {
+	stmtSQL := fmt.Sprintf("CREATE DATABASE AUDIT SPECIFICATION %s FOR SERVER AUDIT %s",
+		quoteIdentifier(spec.Name), quoteIdentifier(spec.Audit))
+	if clauses := auditSpecificationClauses(nil, databaseAuditItems(spec)); len(clauses) > 0 {
+		stmtSQL += " " + strings.Join(clauses, ", ")
+	}
+	stmtSQL += " WITH (STATE = " + onOff(spec.Enabled) + ")"
+	return c.setDatabase(spec.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterDatabaseAuditSpecification disables the specification, changes its audit, adds and drops the action groups and
+// actions, then sets its state, as enabled specifications cannot be altered
+func (c *Connector) AlterDatabaseAuditSpecification(ctx context.Context, oldSpec *model.DatabaseAuditSpecification, spec *model.DatabaseAuditSpecification) error {
+	name := quoteIdentifier(spec.Name)
+	alter := fmt.Sprintf("ALTER DATABASE AUDIT SPECIFICATION %s FOR SERVER AUDIT %s", name, quoteIdentifier(spec.Audit))
+	if clauses := auditSpecificationClauses(databaseAuditItems(oldSpec), databaseAuditItems(spec)); len(clauses) > 0 {
+		alter += " " + strings.Join(clauses, ", ")
+	}
+
+	statements := []string{
+		fmt.Sprintf("ALTER DATABASE AUDIT SPECIFICATION %s WITH (STATE = OFF)", name),
+		alter,
+		fmt.Sprintf("ALTER DATABASE AUDIT SPECIFICATION %s WITH (STATE = %s)", name, onOff(spec.Enabled)),
+	}
+	return c.setDatabase(spec.Database).ExecContext(ctx, strings.Join(statements, "; "))
+}
+
+// DeleteDatabaseAuditSpecification disables the specification before dropping it, as enabled specifications cannot be dropped
+func (c *Connector) DeleteDatabaseAuditSpecification(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[database_audit_specifications] WHERE [name] = @name)
+		BEGIN
+			ALTER DATABASE AUDIT SPECIFICATION %[1]s WITH (STATE = OFF)
+			DROP DATABASE AUDIT SPECIFICATION %[1]s
+		END`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+// databaseAuditItems lists the action groups and actions of the specification, as written in ADD and DROP clauses
+func databaseAuditItems(spec *model.DatabaseAuditSpecification) []string {
+	items := make([]string, 0, len(spec.ActionGroups)+len(spec.Actions))
+	items = append(items, spec.ActionGroups...)
+	for _, a := range spec.Actions {
+		var securable string
+		switch a.Class {
+		case "OBJECT":
+			securable = fmt.Sprintf("OBJECT::%s.%s", quoteIdentifier(a.Schema), quoteIdentifier(a.Object))
+		case "SCHEMA":
+			securable = "SCHEMA::" + quoteIdentifier(a.Schema)
+		default:
+			securable = "DATABASE::" + quoteIdentifier(spec.Database)
+		}
+		items = append(items, fmt.Sprintf("%s ON %s BY %s", a.Action, securable, quoteIdentifier(a.Principal)))
+	}
+	return items
+}
+
+// auditSpecificationClauses adds the new items and drops the removed ones
+func auditSpecificationClauses(oldItems []string, newItems []string) []string {
+	clauses := make([]string, 0)
+	for _, item := range newItems {
+		if !containsString(oldItems, item) {
+			clauses = append(clauses, "ADD ("+item+")")
+		}
+	}
+	for _, item := range oldItems {
+		if !containsString(newItems, item) {
+			clauses = append(clauses, "DROP ("+item+")")
+		}
+	}
+	return clauses
+}
@@ -0,0 +1,28 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// CopyDatabase starts the copy of the source database, then waits for it to complete
+func (c *Connector) CopyDatabase(ctx context.Context, database *model.DatabaseCopy) error {
+	source := quoteIdentifier(database.SourceDatabase)
+	if database.SourceServer != "" {
+		source = quoteIdentifier(database.SourceServer) + "." + source
+	}
+	stmtSQL := fmt.Sprintf("CREATE DATABASE %s AS COPY OF %s", quoteIdentifier(database.Name), source)
+	editionOptions := databaseEditionOptions(&model.Database{ServiceObjective: database.ServiceObjective, ElasticPool: database.ElasticPool})
+	if len(editionOptions) > 0 {
+		stmtSQL += " (" + strings.Join(editionOptions, ", ") + ")"
+	}
+
+	connector := c.setDatabase("master")
+	if err := connector.ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	return connector.WaitDatabaseOperation(ctx, database.Name)
+}
@@ -0,0 +1,154 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// encryptionStates describe the encryption_state of sys.dm_database_encryption_keys
+var encryptionStates = map[int]string{
+	0: "NO_DATABASE_ENCRYPTION_KEY",
+	1: "UNENCRYPTED",
+	2: "ENCRYPTION_IN_PROGRESS",
+	3: "ENCRYPTED",
+	4: "KEY_CHANGE_IN_PROGRESS",
+	5: "DECRYPTION_IN_PROGRESS",
+	6: "PROTECTION_CHANGE_IN_PROGRESS",
+}
+
+const encryptionScanInterval = 5 * time.Second
+
+// GetDatabaseEncryption reads the database encryption key of the database, with the certificate of master
+// encrypting it. Returns nil when the database has no encryption key.
+func (c *Connector) GetDatabaseEncryption(ctx context.Context, database string) (*model.DatabaseEncryption, error) {
+	stmtSQL := `SELECT CASE k.key_algorithm WHEN 'AES' THEN 'AES_' + CAST(k.key_length AS varchar(10)) ELSE k.key_algorithm END,
+		ISNULL(c.name, ''), d.is_encrypted, k.encryption_state
+		FROM [sys].[dm_database_encryption_keys] k
+		JOIN [sys].[databases] d ON d.database_id = k.database_id
+		LEFT JOIN [master].[sys].[certificates] c ON c.thumbprint = k.encryptor_thumbprint
+		WHERE k.database_id = DB_ID(@database)`
+
+	encryption := &model.DatabaseEncryption{Database: database}
+	var state int
+	err := c.setDatabase("master").
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&encryption.Algorithm, &encryption.Certificate, &encryption.Enabled, &state)
+		}, sql.Named("database", database))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	encryption.EncryptionState = encryptionStates[state]
+	return encryption, nil
+}
+
+// CreateDatabaseEncryption creates the database encryption key, and turns encryption on when enabled
+func (c *Connector) CreateDatabaseEncryption(ctx context.Context, encryption *model.DatabaseEncryption) error {
+	stmtSQL := fmt.Sprintf("CREATE DATABASE ENCRYPTION KEY WITH ALGORITHM = %s ENCRYPTION BY SERVER CERTIFICATE %s",
+		encryption.Algorithm, quoteIdentifier(encryption.Certificate))
+	if err := c.setDatabase(encryption.Database).ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+
+	if !encryption.Enabled {
+		return nil
+	}
+	return c.SetDatabaseEncryption(ctx, encryption.Database, true)
+}
+
+// RegenerateDatabaseEncryptionKey replaces the database encryption key by one of the algorithm
+func (c *Connector) RegenerateDatabaseEncryptionKey(ctx context.Context, database string, algorithm string) error {
+	if err := c.WaitDatabaseEncryption(ctx, database); err != nil {
+		return err
+	}
+	stmtSQL := "ALTER DATABASE ENCRYPTION KEY REGENERATE WITH ALGORITHM = " + algorithm
+	if err := c.setDatabase(database).ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	return c.WaitDatabaseEncryption(ctx, database)
+}
+
+// AlterDatabaseEncryptionCertificate encrypts the database encryption key by another certificate of master
+func (c *Connector) AlterDatabaseEncryptionCertificate(ctx context.Context, database string, certificate string) error {
+	if err := c.WaitDatabaseEncryption(ctx, database); err != nil {
+		return err
+	}
+	stmtSQL := "ALTER DATABASE ENCRYPTION KEY ENCRYPTION BY SERVER CERTIFICATE " + quoteIdentifier(certificate)
+	if err := c.setDatabase(database).ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	return c.WaitDatabaseEncryption(ctx, database)
+}
+
+// SetDatabaseEncryption turns encryption on or off, and waits for the encryption or decryption scan to complete
+func (c *Connector) SetDatabaseEncryption(ctx context.Context, database string, enabled bool) error {
+	if err := c.WaitDatabaseEncryption(ctx, database); err != nil {
+		return err
+	}
+	state := "OFF"
+	if enabled {
+		state = "ON"
+	}
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s SET ENCRYPTION %s", quoteIdentifier(database), state)
+	if err := c.setDatabase("master").ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	return c.WaitDatabaseEncryption(ctx, database)
+}
+
+// WaitDatabaseEncryption waits until no encryption, decryption, key or protection change is in progress,
+// as the database encryption key cannot be altered meanwhile. Bounded by the context only, the scan of a large
+// database may last hours.
+func (c *Connector) WaitDatabaseEncryption(ctx context.Context, database string) error {
+	stmtSQL := `SELECT encryption_state, percent_complete FROM [sys].[dm_database_encryption_keys]
+		WHERE database_id = DB_ID(@database)`
+
+	for {
+		var state int
+		var percent float32
+		err := c.setDatabase("master").
+			QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+				return row.Scan(&state, &percent)
+			}, sql.Named("database", database))
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if state != 2 && state != 4 && state != 5 && state != 6 {
+			return nil
+		}
+
+		log.Printf("[INFO] Database %s: %s, %.0f%% complete", database, encryptionStates[state], percent)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database %s still %s: %s", database, encryptionStates[state], ctx.Err())
+		case <-time.After(encryptionScanInterval):
+		}
+	}
+}
+
+// DeleteDatabaseEncryption turns encryption off, then drops the database encryption key once the database is decrypted
+func (c *Connector) DeleteDatabaseEncryption(ctx context.Context, database string) error {
+	encryption, err := c.GetDatabaseEncryption(ctx, database)
+	if err != nil || encryption == nil {
+		return err
+	}
+	if encryption.Enabled {
+		if err := c.SetDatabaseEncryption(ctx, database, false); err != nil {
+			return err
+		}
+	} else if err := c.WaitDatabaseEncryption(ctx, database); err != nil {
+		return err
+	}
+
+	return c.setDatabase(database).ExecContext(ctx, "DROP DATABASE ENCRYPTION KEY")
+}
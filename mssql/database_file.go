@@ -0,0 +1,90 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabaseFile looks the file of the database up by logical name, with its sizes in MB.
+// Returns nil when the file does not exist.
+func (c *Connector) GetDatabaseFile(ctx context.Context, database, name string) (*model.DatabaseFile, error) {
+	stmtSQL := `SELECT f.name, f.type_desc, ISNULL(fg.name, ''), f.physical_name,
+			f.size / 128, CASE WHEN f.max_size = -1 THEN -1 ELSE f.max_size / 128 END,
+			CASE WHEN f.is_percent_growth = 1 THEN 0 ELSE f.growth / 128 END,
+			CASE WHEN f.is_percent_growth = 1 THEN f.growth ELSE 0 END
+		FROM [sys].[database_files] f
+			LEFT JOIN [sys].[filegroups] fg ON fg.data_space_id = f.data_space_id
+		WHERE f.name = @name`
+
+	file := &model.DatabaseFile{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&file.Name, &file.Type, &file.Filegroup, &file.Path,
+				&file.SizeMB, &file.MaxSizeMB, &file.GrowthMB, &file.GrowthPercent)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (c *Connector) AddDatabaseFile(ctx context.Context, file *model.DatabaseFile) error {
+	options := append([]string{"NAME = " + quoteIdentifier(file.Name), "FILENAME = " + quoteString(file.Path)},
+		databaseFileOptions(nil, file)...)
+	kind := "FILE"
+	if file.Type == "LOG" {
+		kind = "LOG FILE"
+	}
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s ADD %s (%s)", quoteIdentifier(file.Database), kind, strings.Join(options, ", "))
+	if file.Filegroup != "" && file.Type != "LOG" {
+		stmtSQL += " TO FILEGROUP " + quoteIdentifier(file.Filegroup)
+	}
+	return c.setDatabase(file.Database).ExecContext(ctx, stmtSQL)
+}
+
+// ModifyDatabaseFile changes the sizes of the file differing from old. Files can only grow, see DBCC SHRINKFILE.
+func (c *Connector) ModifyDatabaseFile(ctx context.Context, old, file *model.DatabaseFile) error {
+	options := databaseFileOptions(old, file)
+	if len(options) == 0 {
+		return nil
+	}
+	options = append([]string{"NAME = " + quoteIdentifier(file.Name)}, options...)
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s MODIFY FILE (%s)", quoteIdentifier(file.Database), strings.Join(options, ", "))
+	return c.setDatabase(file.Database).ExecContext(ctx, stmtSQL)
+}
+
+// RemoveDatabaseFile removes the file, which has to be empty
+func (c *Connector) RemoveDatabaseFile(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[database_files] WHERE name = @name)
+		ALTER DATABASE %s REMOVE FILE %s`, quoteIdentifier(database), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+// databaseFileOptions lists the SIZE, MAXSIZE and FILEGROWTH options set in file and differing from old, when not nil
+func databaseFileOptions(old, file *model.DatabaseFile) []string {
+	if old == nil {
+		old = new(model.DatabaseFile)
+	}
+	options := make([]string, 0)
+	if file.SizeMB > 0 && file.SizeMB != old.SizeMB {
+		options = append(options, fmt.Sprintf("SIZE = %dMB", file.SizeMB))
+	}
+	if file.MaxSizeMB == -1 && old.MaxSizeMB != -1 {
+		options = append(options, "MAXSIZE = UNLIMITED")
+	} else if file.MaxSizeMB > 0 && file.MaxSizeMB != old.MaxSizeMB {
+		options = append(options, fmt.Sprintf("MAXSIZE = %dMB", file.MaxSizeMB))
+	}
+	if file.GrowthPercent > 0 && file.GrowthPercent != old.GrowthPercent {
+		options = append(options, fmt.Sprintf("FILEGROWTH = %d%%", file.GrowthPercent))
+	} else if file.GrowthMB > 0 && file.GrowthMB != old.GrowthMB {
+		options = append(options, fmt.Sprintf("FILEGROWTH = %dMB", file.GrowthMB))
+	}
+	return options
+}
@@ -0,0 +1,39 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabaseFirewallRule looks the database-level firewall rule up by name. Returns nil when the rule does not exist.
+func (c *Connector) GetDatabaseFirewallRule(ctx context.Context, database, name string) (*model.DatabaseFirewallRule, error) {
+	stmtSQL := "SELECT name, start_ip_address, end_ip_address FROM [sys].[database_firewall_rules] WHERE [name] = @name"
+
+	rule := &model.DatabaseFirewallRule{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&rule.Name, &rule.StartIPAddress, &rule.EndIPAddress)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// SetDatabaseFirewallRule creates the database-level firewall rule, or updates the address range of the existing rule
+func (c *Connector) SetDatabaseFirewallRule(ctx context.Context, rule *model.DatabaseFirewallRule) error {
+	stmtSQL := "EXEC [sys].[sp_set_database_firewall_rule] @name = @name, @start_ip_address = @start, @end_ip_address = @end"
+	return c.setDatabase(rule.Database).ExecContext(ctx, stmtSQL,
+		sql.Named("name", rule.Name), sql.Named("start", rule.StartIPAddress), sql.Named("end", rule.EndIPAddress))
+}
+
+func (c *Connector) DeleteDatabaseFirewallRule(ctx context.Context, database, name string) error {
+	stmtSQL := `IF EXISTS (SELECT 1 FROM [sys].[database_firewall_rules] WHERE [name] = @name)
+		EXEC [sys].[sp_delete_database_firewall_rule] @name = @name`
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,94 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabaseOptions reads the options of the database from sys.databases. Returns nil when the database does not exist.
+func (c *Connector) GetDatabaseOptions(ctx context.Context, database string) (*model.DatabaseOptions, error) {
+	stmtSQL := `SELECT recovery_model_desc, is_read_committed_snapshot_on,
+			CAST(CASE WHEN snapshot_isolation_state IN (1, 3) THEN 1 ELSE 0 END AS bit),
+			is_auto_close_on, is_auto_shrink_on, is_auto_update_stats_on, is_trustworthy_on, is_broker_enabled, page_verify_option_desc
+		FROM [sys].[databases] WHERE name = @name`
+
+	options := &model.DatabaseOptions{Database: database}
+	err := c.setDatabase("master").
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&options.RecoveryModel, &options.ReadCommittedSnapshot, &options.AllowSnapshotIsolation,
+				&options.AutoClose, &options.AutoShrink, &options.AutoUpdateStatistics, &options.Trustworthy,
+				&options.BrokerEnabled, &options.PageVerify)
+		}, sql.Named("name", database))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// SetDatabaseOptions sets the options differing from old, one statement each as some options cannot be combined.
+// With rollbackImmediate, the open transactions of the database are rolled back instead of waited for.
+func (c *Connector) SetDatabaseOptions(ctx context.Context, old, options *model.DatabaseOptions, rollbackImmediate bool) error {
+	termination := ""
+	if rollbackImmediate {
+		termination = " WITH ROLLBACK IMMEDIATE"
+	}
+
+	connector := c.setDatabase("master")
+	for _, option := range databaseOptionClauses(old, options) {
+		stmtSQL := fmt.Sprintf("ALTER DATABASE %s SET %s%s", quoteIdentifier(options.Database), option, termination)
+		if err := connector.ExecContext(ctx, stmtSQL); err != nil {
+			return fmt.Errorf("set option %s of database %s: %w", option, options.Database, err)
+		}
+	}
+	return nil
+}
+
+// databaseOptionClauses lists the SET clauses of the options differing from old
+func databaseOptionClauses(old, options *model.DatabaseOptions) []string {
+	clauses := make([]string, 0)
+	if options.RecoveryModel != "" && options.RecoveryModel != old.RecoveryModel {
+		clauses = append(clauses, "RECOVERY "+options.RecoveryModel)
+	}
+	if options.ReadCommittedSnapshot != old.ReadCommittedSnapshot {
+		clauses = append(clauses, "READ_COMMITTED_SNAPSHOT "+onOff(options.ReadCommittedSnapshot))
+	}
+	if options.AllowSnapshotIsolation != old.AllowSnapshotIsolation {
+		clauses = append(clauses, "ALLOW_SNAPSHOT_ISOLATION "+onOff(options.AllowSnapshotIsolation))
+	}
+	if options.AutoClose != old.AutoClose {
+		clauses = append(clauses, "AUTO_CLOSE "+onOff(options.AutoClose))
+	}
+	if options.AutoShrink != old.AutoShrink {
+		clauses = append(clauses, "AUTO_SHRINK "+onOff(options.AutoShrink))
+	}
+	if options.AutoUpdateStatistics != old.AutoUpdateStatistics {
+		clauses = append(clauses, "AUTO_UPDATE_STATISTICS "+onOff(options.AutoUpdateStatistics))
+	}
+	if options.Trustworthy != old.Trustworthy {
+		clauses = append(clauses, "TRUSTWORTHY "+onOff(options.Trustworthy))
+	}
+	if options.BrokerEnabled != old.BrokerEnabled {
+		if options.BrokerEnabled {
+			clauses = append(clauses, "ENABLE_BROKER")
+		} else {
+			clauses = append(clauses, "DISABLE_BROKER")
+		}
+	}
+	if options.PageVerify != "" && options.PageVerify != old.PageVerify {
+		clauses = append(clauses, "PAGE_VERIFY "+options.PageVerify)
+	}
+	return clauses
+}
+
+func onOff(on bool) string {
+	if on {
+		return "ON"
+	}
+	return "OFF"
+}
@@ -0,0 +1,80 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabasePermission reads the database scope permission of the principal.
+// Returns nil when the permission is neither granted nor denied.
+func (c *Connector) GetDatabasePermission(ctx context.Context, database string, principal string, permission string) (*model.DatabasePermission, error) {
+	stmtSQL := `SELECT dp.state_desc
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = dp.grantee_principal_id
+		WHERE dp.class = 0 AND p.name = @principal AND dp.permission_name = @permission`
+
+	var state string
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&state)
+		}, sql.Named("principal", principal), sql.Named("permission", permission))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := &model.DatabasePermission{
+		Database:   database,
+		Principal:  principal,
+		Permission: permission,
+		State:      state,
+	}
+	if state == "GRANT_WITH_GRANT_OPTION" {
+		p.State, p.WithGrantOption = model.PermissionStateGrant, true
+	}
+	return p, nil
+}
+
+// SetDatabasePermission grants or denies the database scope permission
+func (c *Connector) SetDatabasePermission(ctx context.Context, p *model.DatabasePermission) error {
+	stmtSQL := fmt.Sprintf("%s %s TO %s", p.State, p.Permission, quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " WITH GRANT OPTION"
+	}
+	return c.setDatabase(p.Database).ExecContext(ctx, stmtSQL)
+}
+
+// RevokeDatabasePermission removes the grant or deny of the database scope permission,
+// with the permissions granted further by the principal when it had the grant option
+func (c *Connector) RevokeDatabasePermission(ctx context.Context, p *model.DatabasePermission) error {
+	stmtSQL := fmt.Sprintf("REVOKE %s FROM %s", p.Permission, quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " CASCADE"
+	}
+	return c.setDatabase(p.Database).ExecContext(ctx, stmtSQL)
+}
+
+// systemDatabasePrincipalSQL matches the database principals p the server refuses to revoke or remove from roles:
+// public, dbo, guest, INFORMATION_SCHEMA, sys, the fixed roles and the ##MS_...## certificate users
+const systemDatabasePrincipalSQL = "(p.principal_id < 5 OR p.is_fixed_role = 1 OR p.name LIKE '##MS[_]%##')"
+
+// permissionStateSQL matches the permissions dp in the GRANT or DENY state of @state, GRANT including
+// GRANT_WITH_GRANT_OPTION, so that listing the grants of a permission leaves its denials out and conversely
+const permissionStateSQL = "(CASE dp.state WHEN 'D' THEN 'DENY' ELSE 'GRANT' END) = @state"
+
+// GetDatabasePermissionGrantees lists the principals the database scope permission is granted or denied to,
+// depending on state, but system principals
+func (c *Connector) GetDatabasePermissionGrantees(ctx context.Context, database string, permission string, state string) ([]string, error) {
+	stmtSQL := `SELECT p.name
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = dp.grantee_principal_id
+		WHERE dp.class = 0 AND dp.permission_name = @permission AND ` + permissionStateSQL + `
+			AND NOT ` + systemDatabasePrincipalSQL + `
+		ORDER BY p.name`
+	return c.setDatabase(database).queryStrings(ctx, stmtSQL, sql.Named("permission", permission), sql.Named("state", state))
+}
@@ -0,0 +1,134 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabaseRoles lists roles of the database together with their member count, sorted by name
+func (c *Connector) GetDatabaseRoles(ctx context.Context, database string, excludeFixedRoles bool) ([]*model.DatabaseRole, error) {
+	stmtSQL := `SELECT r.principal_id, r.name, r.is_fixed_role, COALESCE(o.name, ''), COUNT(rm.member_principal_id)
+		FROM [sys].[database_principals] r
+			LEFT JOIN [sys].[database_principals] o ON o.principal_id = r.owning_principal_id
+			LEFT JOIN [sys].[database_role_members] rm ON rm.role_principal_id = r.principal_id
+		WHERE r.type = 'R' AND (@exclude_fixed = 0 OR r.is_fixed_role = 0)
+		GROUP BY r.principal_id, r.name, r.is_fixed_role, o.name
+		ORDER BY r.name`
+
+	roles := make([]*model.DatabaseRole, 0)
+	err := c.setDatabase(database).
+		QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
+			for rows.Next() {
+				role := &model.DatabaseRole{Database: database}
+				err := rows.Scan(&role.PrincipalID, &role.Name, &role.IsFixedRole, &role.Owner, &role.MemberCount)
+				if err != nil {
+					return err
+				}
+				roles = append(roles, role)
+			}
+			return rows.Err()
+		}, sql.Named("exclude_fixed", excludeFixedRoles))
+
+	return roles, err
+}
+
+// GetDatabaseRole looks the role up by principal ID, or by name when principalID is zero.
+// Returns nil when the role does not exist.
+func (c *Connector) GetDatabaseRole(ctx context.Context, database string, principalID int, name string) (*model.DatabaseRole, error) {
+	stmtSQL := `SELECT r.principal_id, r.name, r.is_fixed_role, COALESCE(o.name, ''),
+			(SELECT COUNT(*) FROM [sys].[database_role_members] rm WHERE rm.role_principal_id = r.principal_id)
+		FROM [sys].[database_principals] r
+			LEFT JOIN [sys].[database_principals] o ON o.principal_id = r.owning_principal_id
+		WHERE r.type = 'R' AND `
+	if principalID != 0 {
+		stmtSQL += "r.principal_id = @principal_id"
+	} else {
+		stmtSQL += "r.name = @name"
+	}
+
+	role := &model.DatabaseRole{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&role.PrincipalID, &role.Name, &role.IsFixedRole, &role.Owner, &role.MemberCount)
+		}, sql.Named("principal_id", principalID), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+func (c *Connector) CreateDatabaseRole(ctx context.Context, role *model.DatabaseRole) error {
+	stmtSQL := "CREATE ROLE " + quoteIdentifier(role.Name)
+	if role.Owner != "" {
+		stmtSQL += " AUTHORIZATION " + quoteIdentifier(role.Owner)
+	}
+	return c.setDatabase(role.Database).ExecContext(ctx, stmtSQL)
+}
+
+// GetDatabaseRoleMembers lists names of the direct members of the role, sorted by name
+func (c *Connector) GetDatabaseRoleMembers(ctx context.Context, database string, principalID int) ([]string, error) {
+	stmtSQL := `SELECT m.name
+		FROM [sys].[database_role_members] rm
+			INNER JOIN [sys].[database_principals] m ON m.principal_id = rm.member_principal_id
+		WHERE rm.role_principal_id = @principal_id
+		ORDER BY m.name`
+	return c.setDatabase(database).queryStrings(ctx, stmtSQL, sql.Named("principal_id", principalID))
+}
+
+// GetDatabaseRoleMembersByName lists names of the direct members of the role, custom or fixed, sorted by name.
+// System principals, like dbo member of db_owner, are left out.
+func (c *Connector) GetDatabaseRoleMembersByName(ctx context.Context, database string, role string) ([]string, error) {
+	stmtSQL := `SELECT p.name
+		FROM [sys].[database_role_members] rm
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = rm.member_principal_id
+		WHERE rm.role_principal_id = DATABASE_PRINCIPAL_ID(@role) AND NOT ` + systemDatabasePrincipalSQL + `
+		ORDER BY p.name`
+	return c.setDatabase(database).queryStrings(ctx, stmtSQL, sql.Named("role", role))
+}
+
+func (c *Connector) AddDatabaseRoleMember(ctx context.Context, database string, role string, member string) error {
+	stmtSQL := fmt.Sprintf("ALTER ROLE %s ADD MEMBER %s", quoteIdentifier(role), quoteIdentifier(member))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DropDatabaseRoleMember(ctx context.Context, database string, role string, member string) error {
+	stmtSQL := fmt.Sprintf("ALTER ROLE %s DROP MEMBER %s", quoteIdentifier(role), quoteIdentifier(member))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) AlterDatabaseRoleOwner(ctx context.Context, database string, role string, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON ROLE::%s TO %s", quoteIdentifier(role), quoteIdentifier(owner))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) RenameDatabaseRole(ctx context.Context, database string, oldName string, newName string) error {
+	stmtSQL := fmt.Sprintf("ALTER ROLE %s WITH NAME = %s", quoteIdentifier(oldName), quoteIdentifier(newName))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteDatabaseRole(ctx context.Context, role *model.DatabaseRole) error {
+	stmtSQL := "DROP ROLE IF EXISTS " + quoteIdentifier(role.Name)
+	return c.setDatabase(role.Database).ExecContext(ctx, stmtSQL)
+}
+
+// IsDatabaseRoleMember checks whether the principal is a direct member of the role
+func (c *Connector) IsDatabaseRoleMember(ctx context.Context, database string, role string, member string) (bool, error) {
+	stmtSQL := `SELECT COUNT(*)
+		FROM [sys].[database_role_members] rm
+			INNER JOIN [sys].[database_principals] r ON r.principal_id = rm.role_principal_id
+			INNER JOIN [sys].[database_principals] m ON m.principal_id = rm.member_principal_id
+		WHERE r.name = @role AND m.name = @member`
+
+	var count int
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&count)
+		}, sql.Named("role", role), sql.Named("member", member))
+	return count > 0, err
+}
@@ -0,0 +1,116 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// Built-in schemas are dbo, guest, INFORMATION_SCHEMA, sys and the schemas of fixed database roles
+const builtinSchemaSQL = "(s.schema_id BETWEEN 1 AND 4 OR s.schema_id BETWEEN 16384 AND 16399)"
+
+// GetDatabaseSchemas lists schemas of the database matching the LIKE pattern, sorted by name.
+// Owner is left empty when the owning principal no longer exists.
+func (c *Connector) GetDatabaseSchemas(ctx context.Context, database string, pattern string, includeBuiltin bool) ([]*model.DatabaseSchema, error) {
+	stmtSQL := `SELECT s.schema_id, s.name, COALESCE(p.name, ''), s.principal_id, CAST(CASE WHEN ` + builtinSchemaSQL + ` THEN 1 ELSE 0 END AS bit)
+		FROM [sys].[schemas] s
+			LEFT JOIN [sys].[database_principals] p ON p.principal_id = s.principal_id
+		WHERE (@pattern = '' OR s.name LIKE @pattern) AND (@include_builtin = 1 OR NOT ` + builtinSchemaSQL + `)
+		ORDER BY s.name`
+
+	schemas := make([]*model.DatabaseSchema, 0)
+	err := c.setDatabase(database).
+		QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
+			for rows.Next() {
+				s := &model.DatabaseSchema{Database: database}
+				err := rows.Scan(&s.SchemaID, &s.Name, &s.Owner, &s.OwnerPrincipalID, &s.IsBuiltin)
+				if err != nil {
+					return err
+				}
+				schemas = append(schemas, s)
+			}
+			return rows.Err()
+		}, sql.Named("pattern", pattern), sql.Named("include_builtin", includeBuiltin))
+
+	return schemas, err
+}
+
+// GetDatabaseSchema looks the schema up by name, with its owner. Returns nil when the schema does not exist.
+func (c *Connector) GetDatabaseSchema(ctx context.Context, database, name string) (*model.DatabaseSchema, error) {
+	return c.getDatabaseSchema(ctx, database, "s.name = @name", sql.Named("name", name))
+}
+
+// GetDatabaseSchemaByID looks the schema up by its stable identity. Returns nil when the schema does not exist.
+func (c *Connector) GetDatabaseSchemaByID(ctx context.Context, database string, schemaID int) (*model.DatabaseSchema, error) {
+	return c.getDatabaseSchema(ctx, database, "s.schema_id = @schema_id", sql.Named("schema_id", schemaID))
+}
+
+func (c *Connector) getDatabaseSchema(ctx context.Context, database string, filter string, args ...interface{}) (*model.DatabaseSchema, error) {
+	stmtSQL := `SELECT s.schema_id, s.name, COALESCE(p.name, ''), s.principal_id, CAST(CASE WHEN ` + builtinSchemaSQL + ` THEN 1 ELSE 0 END AS bit)
+		FROM [sys].[schemas] s
+			LEFT JOIN [sys].[database_principals] p ON p.principal_id = s.principal_id
+		WHERE ` + filter
+
+	s := &model.DatabaseSchema{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&s.SchemaID, &s.Name, &s.Owner, &s.OwnerPrincipalID, &s.IsBuiltin)
+		}, args...)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Connector) CreateDatabaseSchema(ctx context.Context, s *model.DatabaseSchema) error {
+	stmtSQL := "CREATE SCHEMA " + quoteIdentifier(s.Name)
+	if s.Owner != "" {
+		stmtSQL += " AUTHORIZATION " + quoteIdentifier(s.Owner)
+	}
+	return c.setDatabase(s.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) AlterDatabaseSchemaOwner(ctx context.Context, database, name, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON SCHEMA::%s TO %s", quoteIdentifier(name), quoteIdentifier(owner))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+// dropSchemaObjectsSQL drops the objects of the schema @name: the foreign keys from or to its tables first,
+// then its modules, synonyms and sequences, its tables, and finally its types and XML schema collections.
+// STRING_AGG orders the statements, which concatenation with SELECT @drop += ... ORDER BY does not guarantee.
+const dropSchemaObjectsSQL = `DECLARE @schema_id int = SCHEMA_ID(@name);
+	DECLARE @drop nvarchar(max) = '';
+	SELECT @drop += ISNULL(STRING_AGG(CAST('ALTER TABLE ' + QUOTENAME(OBJECT_SCHEMA_NAME(fk.parent_object_id)) + '.'
+			+ QUOTENAME(OBJECT_NAME(fk.parent_object_id)) + ' DROP CONSTRAINT ' + QUOTENAME(fk.name) + ';' AS nvarchar(max)), ''), '')
+		FROM [sys].[foreign_keys] fk
+		WHERE OBJECTPROPERTY(fk.parent_object_id, 'SchemaId') = @schema_id OR OBJECTPROPERTY(fk.referenced_object_id, 'SchemaId') = @schema_id;
+	SELECT @drop += ISNULL(STRING_AGG(CAST('DROP ' + CASE o.type
+			WHEN 'V' THEN 'VIEW' WHEN 'P' THEN 'PROCEDURE' WHEN 'PC' THEN 'PROCEDURE' WHEN 'SN' THEN 'SYNONYM'
+			WHEN 'SO' THEN 'SEQUENCE' WHEN 'U' THEN 'TABLE' WHEN 'AF' THEN 'AGGREGATE' ELSE 'FUNCTION' END
+			+ ' ' + QUOTENAME(SCHEMA_NAME(o.schema_id)) + '.' + QUOTENAME(o.name) + ';' AS nvarchar(max)), '')
+			WITHIN GROUP (ORDER BY CASE o.type WHEN 'U' THEN 2 ELSE 1 END, o.create_date DESC), '')
+		FROM [sys].[objects] o
+		WHERE o.schema_id = @schema_id AND o.parent_object_id = 0
+			AND o.type IN ('V', 'P', 'PC', 'SN', 'SO', 'U', 'FN', 'IF', 'TF', 'FS', 'FT', 'AF');
+	SELECT @drop += ISNULL(STRING_AGG(CAST('DROP TYPE ' + QUOTENAME(SCHEMA_NAME(t.schema_id)) + '.' + QUOTENAME(t.name) + ';' AS nvarchar(max)), ''), '')
+		FROM [sys].[types] t WHERE t.schema_id = @schema_id AND t.is_user_defined = 1;
+	SELECT @drop += ISNULL(STRING_AGG(CAST('DROP XML SCHEMA COLLECTION ' + QUOTENAME(SCHEMA_NAME(x.schema_id)) + '.'
+			+ QUOTENAME(x.name) + ';' AS nvarchar(max)), ''), '')
+		FROM [sys].[xml_schema_collections] x WHERE x.schema_id = @schema_id;
+	EXEC (@drop)`
+
+// DeleteDatabaseSchema drops the schema, which fails when it still contains objects unless cascade drops them first
+func (c *Connector) DeleteDatabaseSchema(ctx context.Context, database, name string, cascade bool) error {
+	connector := c.setDatabase(database)
+	if cascade {
+		if err := connector.ExecContext(ctx, dropSchemaObjectsSQL, sql.Named("name", name)); err != nil {
+			return err
+		}
+	}
+	return connector.ExecContext(ctx, "DROP SCHEMA IF EXISTS "+quoteIdentifier(name))
+}
@@ -0,0 +1,48 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabaseScopedCredential looks the credential of the database up by name.
+// Returns nil when the credential does not exist.
+func (c *Connector) GetDatabaseScopedCredential(ctx context.Context, database string, name string) (*model.DatabaseScopedCredential, error) {
+	stmtSQL := "SELECT credential_id, name, credential_identity FROM [sys].[database_scoped_credentials] WHERE [name] = @name"
+
+	credential := &model.DatabaseScopedCredential{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&credential.CredentialID, &credential.Name, &credential.Identity)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+// CreateDatabaseScopedCredential creates the credential, the database needs a master key to encrypt the secret
+func (c *Connector) CreateDatabaseScopedCredential(ctx context.Context, credential *model.DatabaseScopedCredential) error {
+	stmtSQL := fmt.Sprintf("CREATE DATABASE SCOPED CREDENTIAL %s WITH %s",
+		quoteIdentifier(credential.Name), credentialOptions(&credential.Credential))
+	return c.setDatabase(credential.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterDatabaseScopedCredential sets the identity and the secret of the credential. An empty secret clears it.
+func (c *Connector) AlterDatabaseScopedCredential(ctx context.Context, credential *model.DatabaseScopedCredential) error {
+	stmtSQL := fmt.Sprintf("ALTER DATABASE SCOPED CREDENTIAL %s WITH %s",
+		quoteIdentifier(credential.Name), credentialOptions(&credential.Credential))
+	return c.setDatabase(credential.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteDatabaseScopedCredential(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[database_scoped_credentials] WHERE [name] = @name) DROP DATABASE SCOPED CREDENTIAL %s",
+		quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
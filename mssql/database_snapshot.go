@@ -0,0 +1,82 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabaseSnapshot looks the snapshot up by name, with its source and sparse files.
+// Returns nil when the snapshot does not exist.
+func (c *Connector) GetDatabaseSnapshot(ctx context.Context, name string) (*model.DatabaseSnapshot, error) {
+	stmtSQL := `SELECT name, ISNULL(DB_NAME(source_database_id), '') FROM [sys].[databases]
+		WHERE name = @name AND source_database_id IS NOT NULL`
+
+	snapshot := new(model.DatabaseSnapshot)
+	connector := c.setDatabase("master")
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&snapshot.Name, &snapshot.SourceDatabase)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Files, err = connector.queryStrings(ctx, `SELECT physical_name FROM [sys].[master_files]
+		WHERE database_id = DB_ID(@name) AND type = 0 ORDER BY file_id`, sql.Named("name", name))
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// CreateDatabaseSnapshot creates the snapshot with a sparse file <snapshot>_<logical name>.ss per data file of
+// the source, in the directory of the snapshot or else next to the data file
+func (c *Connector) CreateDatabaseSnapshot(ctx context.Context, snapshot *model.DatabaseSnapshot) error {
+	connector := c.setDatabase("master")
+	files := make([]string, 0)
+	err := connector.QueryContext(ctx, `SELECT name, physical_name FROM [sys].[master_files]
+		WHERE database_id = DB_ID(@database) AND type = 0 ORDER BY file_id`,
+		func(rows *sql.Rows) error {
+			for rows.Next() {
+				var name, path string
+				if err := rows.Scan(&name, &path); err != nil {
+					return err
+				}
+				files = append(files, fmt.Sprintf("(NAME = %s, FILENAME = %s)", quoteIdentifier(name),
+					quoteString(snapshotFilePath(snapshot, name, path))))
+			}
+			return rows.Err()
+		}, sql.Named("database", snapshot.SourceDatabase))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no data files found for database %s", snapshot.SourceDatabase)
+	}
+
+	stmtSQL := fmt.Sprintf("CREATE DATABASE %s ON %s AS SNAPSHOT OF %s", quoteIdentifier(snapshot.Name),
+		strings.Join(files, ", "), quoteIdentifier(snapshot.SourceDatabase))
+	return connector.ExecContext(ctx, stmtSQL)
+}
+
+// snapshotFilePath returns the path of the sparse file of the data file, with the separator of the directory
+func snapshotFilePath(snapshot *model.DatabaseSnapshot, name, path string) string {
+	directory := snapshot.Directory
+	if directory == "" {
+		directory = path[:strings.LastIndexAny(path, `\/`)+1]
+	}
+	if !strings.HasSuffix(directory, `\`) && !strings.HasSuffix(directory, "/") {
+		if strings.Contains(directory, "/") {
+			directory += "/"
+		} else {
+			directory += `\`
+		}
+	}
+	return fmt.Sprintf("%s%s_%s.ss", directory, snapshot.Name, name)
+}
@@ -0,0 +1,74 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetDatabaseTrigger looks the database DDL trigger up by name, with its events and definition.
+// Returns nil when the trigger does not exist.
+func (c *Connector) GetDatabaseTrigger(ctx context.Context, database, name string) (*model.DatabaseTrigger, error) {
+	stmtSQL := `SELECT t.object_id, t.name, t.is_disabled, ISNULL(m.definition, '')
+		FROM [sys].[triggers] t
+			LEFT JOIN [sys].[sql_modules] m ON m.object_id = t.object_id
+		WHERE t.name = @name AND t.parent_class = 0`
+
+	var id int
+	var disabled bool
+	trigger := &model.DatabaseTrigger{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&id, &trigger.Name, &disabled, &trigger.Definition)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	trigger.Enabled = !disabled
+	trigger.Body, _ = triggerBody(trigger.Definition)
+
+	trigger.Events, err = connector.queryStrings(ctx, `SELECT DISTINCT ISNULL(event_group_type_desc, type_desc)
+		FROM [sys].[trigger_events] WHERE object_id = @id`, sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+	return trigger, nil
+}
+
+func (c *Connector) CreateDatabaseTrigger(ctx context.Context, trigger *model.DatabaseTrigger) error {
+	connector := c.setDatabase(trigger.Database)
+	err := connector.ExecContext(ctx, triggerStatement("CREATE", trigger.Name, "DATABASE", trigger.Events, trigger.Body))
+	if err != nil || trigger.Enabled {
+		return err
+	}
+	return connector.SetDatabaseTriggerEnabled(ctx, trigger.Database, trigger.Name, false)
+}
+
+// AlterDatabaseTrigger changes the events and body of the trigger, then sets its state
+func (c *Connector) AlterDatabaseTrigger(ctx context.Context, trigger *model.DatabaseTrigger) error {
+	connector := c.setDatabase(trigger.Database)
+	err := connector.ExecContext(ctx, triggerStatement("ALTER", trigger.Name, "DATABASE", trigger.Events, trigger.Body))
+	if err != nil {
+		return err
+	}
+	return connector.SetDatabaseTriggerEnabled(ctx, trigger.Database, trigger.Name, trigger.Enabled)
+}
+
+func (c *Connector) SetDatabaseTriggerEnabled(ctx context.Context, database, name string, enabled bool) error {
+	state := "DISABLE"
+	if enabled {
+		state = "ENABLE"
+	}
+	return c.setDatabase(database).ExecContext(ctx, fmt.Sprintf("%s TRIGGER %s ON DATABASE", state, quoteIdentifier(name)))
+}
+
+func (c *Connector) DeleteDatabaseTrigger(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[triggers] WHERE [name] = @name AND parent_class = 0)
+		DROP TRIGGER %s ON DATABASE`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,111 @@
+package mssql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ExecTokenProvider runs a command printing an access token, e.g. a wrapper around a bespoke token broker,
+// as kubectl exec credential plugins do
+type ExecTokenProvider struct {
+	Command string
+	Args    []string
+	// Env is added to the environment of the provider
+	Env     map[string]string
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// execTokenOutput is the JSON output of the command: a kubectl ExecCredential, or a flat object
+// as printed by the Azure CLI. Any other output is taken as the token itself.
+type execTokenOutput struct {
+	Status *struct {
+		Token               string `json:"token"`
+		ExpirationTimestamp string `json:"expirationTimestamp"`
+	} `json:"status"`
+	AccessToken      string `json:"access_token"`
+	AccessTokenCamel string `json:"accessToken"`
+	Token            string `json:"token"`
+	ExpiresOn        int64  `json:"expires_on"`
+	ExpiresAt        string `json:"expires_at"`
+}
+
+// Token returns the token printed by the command, cached until it is about to expire.
+// Tokens without expiry are cached for the whole run.
+func (p *ExecTokenProvider) Token() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token != "" && (p.expires.IsZero() || time.Until(p.expires) > tokenRefreshWithin) {
+		return p.token, nil
+	}
+
+	token, expires, err := p.run()
+	if err != nil {
+		return "", err
+	}
+	p.token, p.expires = token, expires
+	return token, nil
+}
+
+func (p *ExecTokenProvider) run() (string, time.Time, error) {
+	ctx := context.Background()
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Command, p.Args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = os.Environ()
+	for key, value := range p.Env {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+	if err := cmd.Run(); err != nil {
+		return "", time.Time{}, errors.Wrapf(err, "error running token command %s: %s", p.Command, strings.TrimSpace(stderr.String()))
+	}
+
+	output := strings.TrimSpace(stdout.String())
+	if !strings.HasPrefix(output, "{") {
+		if output == "" {
+			return "", time.Time{}, errors.Errorf("error running token command %s: empty output", p.Command)
+		}
+		return output, time.Time{}, nil
+	}
+
+	var out execTokenOutput
+	if err := json.Unmarshal([]byte(output), &out); err != nil {
+		return "", time.Time{}, errors.Wrapf(err, "error running token command %s: unexpected output", p.Command)
+	}
+	token, expires := out.parse()
+	if token == "" {
+		return "", time.Time{}, errors.Errorf("error running token command %s: no token in output", p.Command)
+	}
+	return token, expires, nil
+}
+
+func (o execTokenOutput) parse() (string, time.Time) {
+	if o.Status != nil {
+		expires, _ := time.Parse(time.RFC3339, o.Status.ExpirationTimestamp)
+		return o.Status.Token, expires
+	}
+	token := firstNonEmpty(o.AccessToken, o.AccessTokenCamel, o.Token)
+	if o.ExpiresOn > 0 {
+		return token, time.Unix(o.ExpiresOn, 0)
+	}
+	expires, _ := time.Parse(time.RFC3339, o.ExpiresAt)
+	return token, expires
+}
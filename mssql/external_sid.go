@@ -0,0 +1,34 @@
+package mssql
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ExternalSid computes the SID of an Azure AD principal from its object ID, or from the client ID of an application,
+// so that users can be created WITH SID without the server querying Microsoft Graph, which requires Directory Readers.
+// The SID is the GUID in its mixed-endian binary layout, as CAST(uniqueidentifier AS varbinary) returns it.
+func ExternalSid(objectId string) (string, error) {
+	b, err := hex.DecodeString(strings.ReplaceAll(strings.Trim(objectId, "{}"), "-", ""))
+	if err != nil || len(b) != 16 {
+		return "", fmt.Errorf("invalid Azure AD object ID '%s'", objectId)
+	}
+	b[0], b[1], b[2], b[3] = b[3], b[2], b[1], b[0]
+	b[4], b[5] = b[5], b[4]
+	b[6], b[7] = b[7], b[6]
+	return "0x" + strings.ToUpper(hex.EncodeToString(b)), nil
+}
+
+// externalSidOption returns the WITH options of CREATE USER for the Azure AD principal
+func externalSidOption(objectId string, isGroup bool) (string, error) {
+	sid, err := ExternalSid(objectId)
+	if err != nil {
+		return "", err
+	}
+	principalType := "E"
+	if isGroup {
+		principalType = "X"
+	}
+	return fmt.Sprintf("SID = %s, TYPE = %s", sid, principalType), nil
+}
@@ -0,0 +1,72 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetFilegroup looks the filegroup of the database up by name. Returns nil when the filegroup does not exist.
+func (c *Connector) GetFilegroup(ctx context.Context, database, name string) (*model.Filegroup, error) {
+	stmtSQL := `SELECT name,
+			CASE type WHEN 'FD' THEN 'FILESTREAM' WHEN 'FX' THEN 'MEMORY_OPTIMIZED_DATA' ELSE 'ROWS' END,
+			is_default, is_read_only
+		FROM [sys].[filegroups] WHERE name = @name`
+
+	fg := &model.Filegroup{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&fg.Name, &fg.Type, &fg.Default, &fg.ReadOnly)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fg, nil
+}
+
+func (c *Connector) CreateFilegroup(ctx context.Context, fg *model.Filegroup) error {
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s ADD FILEGROUP %s", quoteIdentifier(fg.Database), quoteIdentifier(fg.Name))
+	if fg.Type != "" && fg.Type != model.FilegroupTypeRows {
+		stmtSQL += " CONTAINS " + fg.Type
+	}
+	return c.setDatabase(fg.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterFilegroup makes the filegroup the default one, or PRIMARY the default one again, and sets it read-only or
+// read-write. Both need the filegroup to contain files.
+func (c *Connector) AlterFilegroup(ctx context.Context, fg *model.Filegroup, setDefault, setReadOnly bool) error {
+	connector := c.setDatabase(fg.Database)
+	database := quoteIdentifier(fg.Database)
+	if setDefault {
+		target := "[PRIMARY]"
+		if fg.Default {
+			target = quoteIdentifier(fg.Name)
+		}
+		if err := connector.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE %s MODIFY FILEGROUP %s DEFAULT", database, target)); err != nil {
+			return err
+		}
+	}
+	if setReadOnly {
+		state := "READ_WRITE"
+		if fg.ReadOnly {
+			state = "READ_ONLY"
+		}
+		return connector.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE %s MODIFY FILEGROUP %s %s", database, quoteIdentifier(fg.Name), state))
+	}
+	return nil
+}
+
+// DeleteFilegroup removes the filegroup, making PRIMARY the default filegroup first when the filegroup is the default one.
+// The files of the filegroup have to be removed first.
+func (c *Connector) DeleteFilegroup(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[filegroups] WHERE name = @name AND is_default = 1)
+			ALTER DATABASE %[1]s MODIFY FILEGROUP [PRIMARY] DEFAULT;
+		IF EXISTS (SELECT 1 FROM [sys].[filegroups] WHERE name = @name)
+			ALTER DATABASE %[1]s REMOVE FILEGROUP %[2]s`, quoteIdentifier(database), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
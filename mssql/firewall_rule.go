@@ -0,0 +1,39 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetFirewallRule looks the server-level firewall rule up by name. Returns nil when the rule does not exist.
+func (c *Connector) GetFirewallRule(ctx context.Context, name string) (*model.FirewallRule, error) {
+	stmtSQL := "SELECT name, start_ip_address, end_ip_address FROM [sys].[firewall_rules] WHERE [name] = @name"
+
+	rule := new(model.FirewallRule)
+	err := c.setDatabase("master").
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&rule.Name, &rule.StartIPAddress, &rule.EndIPAddress)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// SetFirewallRule creates the server-level firewall rule, or updates the address range of the existing rule
+func (c *Connector) SetFirewallRule(ctx context.Context, rule *model.FirewallRule) error {
+	stmtSQL := "EXEC [sys].[sp_set_firewall_rule] @name = @name, @start_ip_address = @start, @end_ip_address = @end"
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL,
+		sql.Named("name", rule.Name), sql.Named("start", rule.StartIPAddress), sql.Named("end", rule.EndIPAddress))
+}
+
+func (c *Connector) DeleteFirewallRule(ctx context.Context, name string) error {
+	stmtSQL := `IF EXISTS (SELECT 1 FROM [sys].[firewall_rules] WHERE [name] = @name)
+		EXEC [sys].[sp_delete_firewall_rule] @name = @name`
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
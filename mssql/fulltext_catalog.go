@@ -0,0 +1,68 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetFulltextCatalog looks the full-text catalog up by name, with its owner. Returns nil when the catalog does not exist.
+func (c *Connector) GetFulltextCatalog(ctx context.Context, database, name string) (*model.FulltextCatalog, error) {
+	stmtSQL := `SELECT c.fulltext_catalog_id, c.name, c.is_accent_sensitivity_on, c.is_default, COALESCE(p.name, '')
+		FROM [sys].[fulltext_catalogs] c
+			LEFT JOIN [sys].[database_principals] p ON p.principal_id = c.principal_id
+		WHERE c.name = @name`
+
+	var accentSensitive bool
+	catalog := &model.FulltextCatalog{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&catalog.CatalogID, &catalog.Name, &accentSensitive, &catalog.Default, &catalog.Owner)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	catalog.AccentSensitive = &accentSensitive
+	return catalog, nil
+}
+
+func (c *Connector) CreateFulltextCatalog(ctx context.Context, catalog *model.FulltextCatalog) error {
+	stmtSQL := "CREATE FULLTEXT CATALOG " + quoteIdentifier(catalog.Name)
+	if catalog.AccentSensitive != nil {
+		stmtSQL += " WITH ACCENT_SENSITIVITY = " + onOff(*catalog.AccentSensitive)
+	}
+	if catalog.Default {
+		stmtSQL += " AS DEFAULT"
+	}
+	if catalog.Owner != "" {
+		stmtSQL += " AUTHORIZATION " + quoteIdentifier(catalog.Owner)
+	}
+	return c.setDatabase(catalog.Database).ExecContext(ctx, stmtSQL)
+}
+
+// RebuildFulltextCatalog changes the accent sensitivity of the catalog, rebuilding its full-text indexes
+func (c *Connector) RebuildFulltextCatalog(ctx context.Context, database, name string, accentSensitive bool) error {
+	stmtSQL := fmt.Sprintf("ALTER FULLTEXT CATALOG %s REBUILD WITH ACCENT_SENSITIVITY = %s", quoteIdentifier(name), onOff(accentSensitive))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+// SetDefaultFulltextCatalog makes the catalog the default of the database, in place of the previous one
+func (c *Connector) SetDefaultFulltextCatalog(ctx context.Context, database, name string) error {
+	return c.setDatabase(database).ExecContext(ctx, fmt.Sprintf("ALTER FULLTEXT CATALOG %s AS DEFAULT", quoteIdentifier(name)))
+}
+
+func (c *Connector) AlterFulltextCatalogOwner(ctx context.Context, database, name, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON FULLTEXT CATALOG::%s TO %s", quoteIdentifier(name), quoteIdentifier(owner))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteFulltextCatalog(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[fulltext_catalogs] WHERE [name] = @name)
+		DROP FULLTEXT CATALOG %s`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
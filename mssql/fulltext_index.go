@@ -0,0 +1,144 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetFulltextIndex looks the full-text index of the table up, with its columns ordered by column ID.
+// Returns nil when the table has no full-text index.
+func (c *Connector) GetFulltextIndex(ctx context.Context, database, schema, table string) (*model.FulltextIndex, error) {
+	stmtSQL := `SELECT fi.object_id, OBJECT_SCHEMA_NAME(fi.object_id), OBJECT_NAME(fi.object_id), i.name, c.name,
+			fi.change_tracking_state_desc
+		FROM [sys].[fulltext_indexes] fi
+			JOIN [sys].[indexes] i ON i.object_id = fi.object_id AND i.index_id = fi.unique_index_id
+			JOIN [sys].[fulltext_catalogs] c ON c.fulltext_catalog_id = fi.fulltext_catalog_id
+		WHERE fi.object_id = OBJECT_ID(QUOTENAME(@schema) + '.' + QUOTENAME(@table))`
+
+	var objectID int
+	index := &model.FulltextIndex{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&objectID, &index.Schema, &index.Table, &index.KeyIndex, &index.Catalog, &index.ChangeTracking)
+	}, sql.Named("schema", schema), sql.Named("table", table))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index.Columns = make([]model.FulltextIndexColumn, 0)
+	err = connector.QueryContext(ctx, `SELECT c.name, ISNULL(l.name, CAST(ic.language_id AS nvarchar(10))), ISNULL(t.name, '')
+		FROM [sys].[fulltext_index_columns] ic
+			JOIN [sys].[columns] c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+			LEFT JOIN [sys].[columns] t ON t.object_id = ic.object_id AND t.column_id = ic.type_column_id
+			LEFT JOIN [sys].[fulltext_languages] l ON l.lcid = ic.language_id
+		WHERE ic.object_id = @object_id
+		ORDER BY ic.column_id`, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var column model.FulltextIndexColumn
+			if err := rows.Scan(&column.Name, &column.Language, &column.TypeColumn); err != nil {
+				return err
+			}
+			index.Columns = append(index.Columns, column)
+		}
+		return rows.Err()
+	}, sql.Named("object_id", objectID))
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func (c *Connector) CreateFulltextIndex(ctx context.Context, index *model.FulltextIndex) error {
+	columns := make([]string, 0, len(index.Columns))
+	for _, column := range index.Columns {
+		columns = append(columns, fulltextColumnDefinition(column))
+	}
+	stmtSQL := fmt.Sprintf("CREATE FULLTEXT INDEX ON %s.%s (%s) KEY INDEX %s", quoteIdentifier(index.Schema),
+		quoteIdentifier(index.Table), strings.Join(columns, ", "), quoteIdentifier(index.KeyIndex))
+	if index.Catalog != "" {
+		stmtSQL += " ON " + quoteIdentifier(index.Catalog)
+	}
+	stmtSQL += " WITH CHANGE_TRACKING = " + index.ChangeTracking
+	return c.setDatabase(index.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterFulltextIndex drops the columns removed or changed since old, adds the new and changed ones, then sets
+// the change tracking of the index. Full-text indexes cannot alter their columns in place.
+func (c *Connector) AlterFulltextIndex(ctx context.Context, old, index *model.FulltextIndex) error {
+	target := fmt.Sprintf("%s.%s", quoteIdentifier(index.Schema), quoteIdentifier(index.Table))
+	current := make(map[string]model.FulltextIndexColumn)
+	for _, column := range old.Columns {
+		current[strings.ToLower(column.Name)] = column
+	}
+	wanted := make(map[string]model.FulltextIndexColumn)
+	for _, column := range index.Columns {
+		wanted[strings.ToLower(column.Name)] = column
+	}
+
+	drop := make([]string, 0)
+	for key, column := range current {
+		if w, ok := wanted[key]; !ok || !sameFulltextColumn(column, w) {
+			drop = append(drop, quoteIdentifier(column.Name))
+		}
+	}
+	add := make([]string, 0)
+	for _, column := range index.Columns {
+		if o, ok := current[strings.ToLower(column.Name)]; !ok || !sameFulltextColumn(o, column) {
+			add = append(add, fulltextColumnDefinition(column))
+		}
+	}
+
+	// statements changing columns populate the index again, unless change tracking is off
+	population := ""
+	if index.ChangeTracking == "OFF" {
+		population = " WITH NO POPULATION"
+	}
+	statements := make([]string, 0)
+	if len(drop) > 0 {
+		statements = append(statements, fmt.Sprintf("ALTER FULLTEXT INDEX ON %s DROP (%s)%s", target, strings.Join(drop, ", "), population))
+	}
+	if len(add) > 0 {
+		statements = append(statements, fmt.Sprintf("ALTER FULLTEXT INDEX ON %s ADD (%s)%s", target, strings.Join(add, ", "), population))
+	}
+	if index.ChangeTracking != old.ChangeTracking {
+		statements = append(statements, fmt.Sprintf("ALTER FULLTEXT INDEX ON %s SET CHANGE_TRACKING = %s", target, index.ChangeTracking))
+	}
+
+	connector := c.setDatabase(index.Database)
+	for _, stmtSQL := range statements {
+		if err := connector.ExecContext(ctx, stmtSQL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Connector) DeleteFulltextIndex(ctx context.Context, database, schema, table string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[fulltext_indexes] WHERE object_id = OBJECT_ID(@table))
+		DROP FULLTEXT INDEX ON %[1]s`, quoteIdentifier(schema)+"."+quoteIdentifier(table))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("table", quoteIdentifier(schema)+"."+quoteIdentifier(table)))
+}
+
+// sameFulltextColumn tells whether the column is indexed the same way, a language left empty matching any
+func sameFulltextColumn(old, new model.FulltextIndexColumn) bool {
+	return strings.EqualFold(old.TypeColumn, new.TypeColumn) &&
+		(new.Language == "" || strings.EqualFold(old.Language, new.Language))
+}
+
+func fulltextColumnDefinition(column model.FulltextIndexColumn) string {
+	definition := quoteIdentifier(column.Name)
+	if column.TypeColumn != "" {
+		definition += " TYPE COLUMN " + quoteIdentifier(column.TypeColumn)
+	}
+	if column.Language != "" {
+		definition += " LANGUAGE " + quoteString(column.Language)
+	}
+	return definition
+}
@@ -0,0 +1,68 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// functionHeader matches the CREATE FUNCTION header of a function definition, capturing the parameters, the return
+// type and the WITH options, up to the AS preceding the body
+var functionHeader = regexp.MustCompile(`(?is)^\s*(?:CREATE|ALTER)\s+(?:OR\s+ALTER\s+)?FUNCTION\s+` + moduleName +
+	`\s*\((.*?)\)\s*RETURNS\s+(.+?)` + moduleOptions + `\s+AS\b`)
+
+// GetFunction looks the function up by schema and name, with its type, its definition and the parameters, return
+// type and body parsed from it. Returns nil when the function does not exist.
+func (c *Connector) GetFunction(ctx context.Context, database, schema, name string) (*model.Function, error) {
+	stmtSQL := `SELECT SCHEMA_NAME(o.schema_id), o.name, o.type_desc, ISNULL(m.definition, ''), ISNULL(m.is_schema_bound, 0)
+		FROM [sys].[objects] o
+			LEFT JOIN [sys].[sql_modules] m ON m.object_id = o.object_id
+		WHERE o.schema_id = SCHEMA_ID(@schema) AND o.name = @name AND o.type IN ('FN', 'IF', 'TF')`
+
+	function := &model.Function{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&function.Schema, &function.Name, &function.Type, &function.Definition, &function.SchemaBinding)
+		}, sql.Named("schema", schema), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if match := functionHeader.FindStringSubmatch(function.Definition); match != nil {
+		function.Parameters = strings.TrimSpace(match[1])
+		function.Returns = strings.TrimSpace(match[2])
+		function.Body = strings.TrimSpace(function.Definition[len(match[0]):])
+	}
+	return function, nil
+}
+
+// SameFunctionDefinition tells whether the definition read back from the server is the one created by the function
+func SameFunctionDefinition(function *model.Function, definition string) bool {
+	return sameModuleDefinition(definition, functionStatement(function))
+}
+
+// CreateOrAlterFunction creates the function, or alters it keeping its permissions.
+// Altering fails when the function changes from scalar to table-valued or from inline to multi-statement.
+func (c *Connector) CreateOrAlterFunction(ctx context.Context, function *model.Function) error {
+	return c.setDatabase(function.Database).ExecContext(ctx, functionStatement(function))
+}
+
+func (c *Connector) DeleteFunction(ctx context.Context, database, schema, name string) error {
+	stmtSQL := fmt.Sprintf("DROP FUNCTION IF EXISTS %s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func functionStatement(function *model.Function) string {
+	stmtSQL := fmt.Sprintf("CREATE OR ALTER FUNCTION %s.%s (%s) RETURNS %s", quoteIdentifier(function.Schema),
+		quoteIdentifier(function.Name), strings.TrimSpace(function.Parameters), strings.TrimSpace(function.Returns))
+	if function.SchemaBinding {
+		stmtSQL += " WITH SCHEMABINDING"
+	}
+	return stmtSQL + " AS\n" + strings.TrimSpace(function.Body)
+}
@@ -0,0 +1,111 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// geoReplicationInterval is the delay between two polls of the seeding and failover of geo-replication links
+const geoReplicationInterval = 10 * time.Second
+
+// GetGeoReplicationLink looks the link of the database to the partner server up in sys.geo_replication_links.
+// Returns nil when the link does not exist.
+func (c *Connector) GetGeoReplicationLink(ctx context.Context, database, partnerServer string) (*model.GeoReplicationLink, error) {
+	stmtSQL := `SELECT partner_server, partner_database, secondary_allow_connections_desc, role_desc, replication_state_desc
+		FROM [sys].[geo_replication_links]
+		WHERE database_id = DB_ID(@database) AND partner_server = @partner`
+
+	link := &model.GeoReplicationLink{Database: database}
+	err := c.setDatabase("master").
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&link.PartnerServer, &link.PartnerDatabase, &link.AllowConnections, &link.Role, &link.ReplicationState)
+		}, sql.Named("database", database), sql.Named("partner", partnerServer))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// AddSecondary creates the secondary of the database on the partner server, then waits for its seeding to complete
+func (c *Connector) AddSecondary(ctx context.Context, link *model.GeoReplicationLink) error {
+	options := []string{"ALLOW_CONNECTIONS = " + link.AllowConnections}
+	if link.ServiceObjective != "" {
+		options = append(options, "SERVICE_OBJECTIVE = "+quoteString(link.ServiceObjective))
+	}
+	stmtSQL := fmt.Sprintf("ALTER DATABASE %s ADD SECONDARY ON SERVER %s WITH (%s)",
+		quoteIdentifier(link.Database), quoteIdentifier(link.PartnerServer), strings.Join(options, ", "))
+
+	connector := c.setDatabase("master")
+	if err := connector.ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	return connector.waitGeoReplicationLink(ctx, link.Database, link.PartnerServer, func(link *model.GeoReplicationLink) bool {
+		return link.ReplicationState != "PENDING" && link.ReplicationState != "SEEDING"
+	})
+}
+
+// FailoverDatabase makes the secondary database of the connected server the primary, allowing data loss when forced.
+// The former primary on the partner server becomes the secondary.
+func (c *Connector) FailoverDatabase(ctx context.Context, database, partnerServer string, forced bool) error {
+	failover := "FAILOVER"
+	if forced {
+		failover = "FORCE_FAILOVER_ALLOW_DATA_LOSS"
+	}
+
+	connector := c.setDatabase("master")
+	if err := connector.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE %s %s", quoteIdentifier(database), failover)); err != nil {
+		return err
+	}
+	return connector.waitGeoReplicationLink(ctx, database, partnerServer, func(link *model.GeoReplicationLink) bool {
+		return link.Role == model.GeoReplicationRolePrimary
+	})
+}
+
+// RemoveSecondary removes the link of the primary database to its secondary on the partner server,
+// the secondary being kept as a standalone database
+func (c *Connector) RemoveSecondary(ctx context.Context, database, partnerServer string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[geo_replication_links] WHERE database_id = DB_ID(@database) AND partner_server = @partner)
+		ALTER DATABASE %s REMOVE SECONDARY ON SERVER %s`, quoteIdentifier(database), quoteIdentifier(partnerServer))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("database", database), sql.Named("partner", partnerServer))
+}
+
+// GetServerName returns @@SERVERNAME, the name of the logical server in Azure SQL Database
+func (c *Connector) GetServerName(ctx context.Context) (string, error) {
+	var name string
+	err := c.QueryRowContext(ctx, "SELECT @@SERVERNAME", func(row *sql.Row) error {
+		return row.Scan(&name)
+	})
+	return name, err
+}
+
+// waitGeoReplicationLink polls the link until done, failing when the link is gone
+func (c *Connector) waitGeoReplicationLink(ctx context.Context, database, partnerServer string, done func(*model.GeoReplicationLink) bool) error {
+	for {
+		link, err := c.GetGeoReplicationLink(ctx, database, partnerServer)
+		if err != nil {
+			return err
+		}
+		if link == nil {
+			return fmt.Errorf("geo-replication link of database %s to server %s not found", database, partnerServer)
+		}
+		if done(link) {
+			return nil
+		}
+
+		log.Printf("[INFO] Database %s: %s geo-replication link to server %s %s", database, link.Role, partnerServer, link.ReplicationState)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("geo-replication link of database %s to server %s still %s: %s", database, partnerServer, link.ReplicationState, ctx.Err())
+		case <-time.After(geoReplicationInterval):
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package mssql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"log"
+	"time"
+
+	mssql "github.com/denisenkom/go-mssqldb"
+)
+
+const (
+	// healthCheckAfter is how long a pooled connection stays unused before it is pinged on reuse
+	healthCheckAfter   = 30 * time.Second
+	healthCheckTimeout = 5 * time.Second
+)
+
+// checkedConnector creates connections pinged before being reused after a while, so that connections
+// closed in the meantime by a gateway or a firewall are replaced instead of failing the next statement.
+// The session IDs of the connections are recorded in the pool, so that the provider never kills its own sessions.
+type checkedConnector struct {
+	driver.Connector
+	pool *dbPool
+}
+
+func (c checkedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mc, ok := conn.(*mssql.Conn)
+	if !ok {
+		return conn, nil
+	}
+	session, err := sessionID(ctx, mc)
+	if err != nil {
+		mc.Close()
+		return nil, err
+	}
+	c.pool.addSession(session)
+	return &checkedConn{Conn: mc, used: time.Now(), pool: c.pool, session: session}, nil
+}
+
+// sessionID returns the @@SPID of the connection
+func sessionID(ctx context.Context, conn *mssql.Conn) (int, error) {
+	stmt, err := conn.PrepareContext(ctx, "SELECT @@SPID")
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	rows, err := stmt.(driver.StmtQueryContext).QueryContext(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	values := make([]driver.Value, 1)
+	if err = rows.Next(values); err != nil {
+		return 0, err
+	}
+	id, ok := values[0].(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected @@SPID %v", values[0])
+	}
+	return int(id), nil
+}
+
+// checkedConn is a driver connection, with a health check when database/sql takes it out of the pool
+type checkedConn struct {
+	*mssql.Conn
+	used    time.Time
+	pool    *dbPool
+	session int
+}
+
+func (c *checkedConn) Close() error {
+	// the server may give the session ID to another connection once this one is closed
+	c.pool.removeSession(c.session)
+	return c.Conn.Close()
+}
+
+func (c *checkedConn) ResetSession(ctx context.Context) error {
+	if err := c.Conn.ResetSession(ctx); err != nil {
+		return err
+	}
+	if time.Since(c.used) > healthCheckAfter {
+		ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		defer cancel()
+		if err := c.Conn.Ping(ctx); err != nil {
+			// database/sql discards the connection and opens another one
+			log.Printf("[DEBUG] Pooled connection is stale, reconnecting: %s", err)
+			return driver.ErrBadConn
+		}
+	}
+	c.used = time.Now()
+	return nil
+}
@@ -0,0 +1,73 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetImpersonatePermission reads the IMPERSONATE permission of the principal on the user or login.
+// Returns nil when the permission is not granted.
+func (c *Connector) GetImpersonatePermission(ctx context.Context, scope string, database string, target string, principal string) (*model.ImpersonatePermission, error) {
+	conn := c.setDatabase(database)
+	stmtSQL := `SELECT dp.state_desc
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] t ON t.principal_id = dp.major_id
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = dp.grantee_principal_id
+		WHERE dp.class = 4 AND dp.permission_name = 'IMPERSONATE' AND dp.state IN ('G', 'W')
+			AND t.name = @target AND p.name = @principal`
+	if scope == model.ImpersonateScopeLogin {
+		conn = c.setDatabase("master")
+		stmtSQL = `SELECT sp.state_desc
+		FROM [sys].[server_permissions] sp
+			INNER JOIN [sys].[server_principals] t ON t.principal_id = sp.major_id
+			INNER JOIN [sys].[server_principals] p ON p.principal_id = sp.grantee_principal_id
+		WHERE sp.class = 101 AND sp.permission_name = 'IMPERSONATE' AND sp.state IN ('G', 'W')
+			AND t.name = @target AND p.name = @principal`
+	}
+
+	var state string
+	err := conn.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&state)
+	}, sql.Named("target", target), sql.Named("principal", principal))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ImpersonatePermission{
+		Scope:           scope,
+		Database:        database,
+		Target:          target,
+		Principal:       principal,
+		WithGrantOption: state == "GRANT_WITH_GRANT_OPTION",
+	}, nil
+}
+
+func (c *Connector) GrantImpersonatePermission(ctx context.Context, p *model.ImpersonatePermission) error {
+	stmtSQL := fmt.Sprintf("GRANT IMPERSONATE ON %s::%s TO %s", p.Scope, quoteIdentifier(p.Target), quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " WITH GRANT OPTION"
+	}
+	return c.impersonateDatabase(p).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) RevokeImpersonatePermission(ctx context.Context, p *model.ImpersonatePermission) error {
+	stmtSQL := fmt.Sprintf("REVOKE IMPERSONATE ON %s::%s FROM %s", p.Scope, quoteIdentifier(p.Target), quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " CASCADE"
+	}
+	return c.impersonateDatabase(p).ExecContext(ctx, stmtSQL)
+}
+
+// impersonateDatabase is the database the permission is granted in, master for logins
+func (c *Connector) impersonateDatabase(p *model.ImpersonatePermission) *Connector {
+	if p.Scope == model.ImpersonateScopeLogin {
+		return c.setDatabase("master")
+	}
+	return c.setDatabase(p.Database)
+}
@@ -0,0 +1,121 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetIndex looks the index of the table up by name, with its key and included columns and its options.
+// Indexes of PRIMARY KEY and UNIQUE constraints are ignored. Returns nil when the index does not exist.
+func (c *Connector) GetIndex(ctx context.Context, database, schema, table, name string) (*model.Index, error) {
+	stmtSQL := `SELECT i.object_id, i.index_id, OBJECT_SCHEMA_NAME(i.object_id), OBJECT_NAME(i.object_id), i.name,
+			IIF(i.type = 1, 1, 0), i.is_unique, ISNULL(i.filter_definition, ''), i.fill_factor,
+			ISNULL((SELECT TOP 1 p.data_compression_desc FROM [sys].[partitions] p
+				WHERE p.object_id = i.object_id AND p.index_id = i.index_id ORDER BY p.partition_number), 'NONE')
+		FROM [sys].[indexes] i
+		WHERE i.object_id = OBJECT_ID(QUOTENAME(@schema) + '.' + QUOTENAME(@table)) AND i.name = @name
+			AND i.is_primary_key = 0 AND i.is_unique_constraint = 0`
+
+	var objectID, indexID int
+	index := &model.Index{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&objectID, &indexID, &index.Schema, &index.Table, &index.Name, &index.Clustered, &index.Unique,
+			&index.Filter, &index.FillFactor, &index.Compression)
+	}, sql.Named("schema", schema), sql.Named("table", table), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index.Columns = make([]model.IndexColumn, 0)
+	index.Include = make([]string, 0)
+	err = connector.QueryContext(ctx, `SELECT c.name, ic.is_descending_key, ic.is_included_column
+		FROM [sys].[index_columns] ic
+			JOIN [sys].[columns] c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+		WHERE ic.object_id = @object_id AND ic.index_id = @index_id AND (ic.key_ordinal > 0 OR ic.is_included_column = 1)
+		ORDER BY ic.is_included_column, ic.key_ordinal, ic.index_column_id`, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var column model.IndexColumn
+			var included bool
+			if err := rows.Scan(&column.Name, &column.Descending, &included); err != nil {
+				return err
+			}
+			if included {
+				index.Include = append(index.Include, column.Name)
+			} else {
+				index.Columns = append(index.Columns, column)
+			}
+		}
+		return rows.Err()
+	}, sql.Named("object_id", objectID), sql.Named("index_id", indexID))
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func (c *Connector) CreateIndex(ctx context.Context, index *model.Index) error {
+	return c.setDatabase(index.Database).ExecContext(ctx, indexStatement(index, false))
+}
+
+// RecreateIndex rebuilds the index with its new definition and options using DROP_EXISTING, keeping it available
+// to queries until the new one is built. A clustered index can not be made nonclustered this way.
+func (c *Connector) RecreateIndex(ctx context.Context, index *model.Index) error {
+	return c.setDatabase(index.Database).ExecContext(ctx, indexStatement(index, true))
+}
+
+func (c *Connector) DeleteIndex(ctx context.Context, database, schema, table, name string) error {
+	stmtSQL := fmt.Sprintf("DROP INDEX IF EXISTS %s ON %s.%s", quoteIdentifier(name), quoteIdentifier(schema), quoteIdentifier(table))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func indexStatement(index *model.Index, dropExisting bool) string {
+	kind := "NONCLUSTERED"
+	if index.Clustered {
+		kind = "CLUSTERED"
+	}
+	if index.Unique {
+		kind = "UNIQUE " + kind
+	}
+	columns := make([]string, 0, len(index.Columns))
+	for _, column := range index.Columns {
+		if column.Descending {
+			columns = append(columns, quoteIdentifier(column.Name)+" DESC")
+		} else {
+			columns = append(columns, quoteIdentifier(column.Name))
+		}
+	}
+	stmtSQL := fmt.Sprintf("CREATE %s INDEX %s ON %s.%s (%s)", kind, quoteIdentifier(index.Name),
+		quoteIdentifier(index.Schema), quoteIdentifier(index.Table), strings.Join(columns, ", "))
+
+	if len(index.Include) > 0 {
+		include := make([]string, 0, len(index.Include))
+		for _, column := range index.Include {
+			include = append(include, quoteIdentifier(column))
+		}
+		stmtSQL += fmt.Sprintf(" INCLUDE (%s)", strings.Join(include, ", "))
+	}
+	if index.Filter != "" {
+		stmtSQL += " WHERE " + index.Filter
+	}
+
+	options := make([]string, 0)
+	if dropExisting {
+		options = append(options, "DROP_EXISTING = ON")
+	}
+	if index.Online {
+		options = append(options, "ONLINE = ON")
+	}
+	if index.FillFactor > 0 {
+		options = append(options, fmt.Sprintf("FILLFACTOR = %d", index.FillFactor))
+	}
+	options = append(options, "DATA_COMPRESSION = "+index.Compression)
+	return stmtSQL + fmt.Sprintf(" WITH (%s)", strings.Join(options, ", "))
+}
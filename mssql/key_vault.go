@@ -0,0 +1,75 @@
+package mssql
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const keyVaultAPIVersion = "7.3"
+
+// ReadKeyVaultSecret returns the value of a Key Vault secret, e.g. https://my-vault.vault.azure.net/secrets/sql-password
+// (latest version) or .../secrets/sql-password/<version>, authenticating with the credential of the login
+func (l *AzureLogin) ReadKeyVaultSecret(secretID string) (string, error) {
+	u, err := url.Parse(secretID)
+	if err != nil || u.Scheme != "https" || !strings.HasPrefix(u.Path, "/secrets/") {
+		return "", errors.Errorf("invalid Key Vault secret ID %q, expected https://<vault>/secrets/<name>[/<version>]", secretID)
+	}
+
+	env, err := l.environment()
+	if err != nil {
+		return "", err
+	}
+	// the cached token is for SQL, Key Vault needs its own
+	vault := *l
+	vault.cache = nil
+	vault.Resource = strings.TrimSuffix(env.ResourceIdentifiers.KeyVault, "/")
+	token, err := vault.accessToken(vault.Resource)
+	if err != nil {
+		return "", errors.Wrap(err, "reading Key Vault secret")
+	}
+
+	query := u.Query()
+	query.Set("api-version", keyVaultAPIVersion)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "reading Key Vault secret %s", secretID)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Value string `json:"value"`
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", errors.Wrapf(err, "reading Key Vault secret %s: %s", secretID, resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("reading Key Vault secret %s: %s: %s", secretID, body.Error.Code, body.Error.Message)
+	}
+	return body.Value, nil
+}
+
+// KeyVaultSecret is an Azure Key Vault secret, read with the credential of Login
+type KeyVaultSecret struct {
+	Login    *AzureLogin
+	SecretID string
+}
+
+func (k *KeyVaultSecret) ReadSecret() (string, error) {
+	return k.Login.ReadKeyVaultSecret(k.SecretID)
+}
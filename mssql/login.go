@@ -0,0 +1,162 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// IsSid checks whether the ID is a SID in 0x... hex notation rather than a login name
+func IsSid(id string) bool {
+	return strings.HasPrefix(id, "0x") && len(id) > 2
+}
+
+// GetLogin looks the login up by SID, or by name when SID is empty. Returns nil when the login does not exist.
+func (c *Connector) GetLogin(ctx context.Context, sid string, name string) (*model.Login, error) {
+	// sys.sql_logins has the password policy of SQL logins, external logins have none
+	stmtSQL := `SELECT p.name, p.type_desc, CONVERT(varchar(172), p.sid, 1), p.default_database_name, p.default_language_name,
+			CASE WHEN p.type IN ('E', 'X') THEN LOWER(CONVERT(nvarchar(36), CAST(p.sid AS uniqueidentifier))) ELSE '' END,
+			l.is_policy_checked, l.is_expiration_checked, p.is_disabled, ISNULL(CAST(LOGINPROPERTY(p.name, 'IsLocked') AS int), 0)
+		FROM [master].[sys].[server_principals] p
+			LEFT JOIN [master].[sys].[sql_logins] l ON l.principal_id = p.principal_id
+		WHERE p.type IN ('S', 'E', 'X') AND `
+	if sid != "" {
+		stmtSQL += "p.sid = CONVERT(varbinary(85), @sid, 1)"
+	} else {
+		stmtSQL += "p.[name] = @name"
+	}
+
+	var defaultDatabase, defaultLanguage model.NullString
+	var checkPolicy, checkExpiration sql.NullBool
+	login := &model.Login{Options: make(model.OptionsList)}
+	err := c.QueryRowContext(ctx, stmtSQL, func(r *sql.Row) error {
+		return r.Scan(&login.Name, &login.Type, &login.Sid, &defaultDatabase, &defaultLanguage, &login.ObjectId,
+			&checkPolicy, &checkExpiration, &login.Disabled, &login.Locked)
+	}, sql.Named("sid", sid), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	login.External = login.IsExternal()
+	login.DefaultDatabase, login.DefaultLanguage = string(defaultDatabase), string(defaultLanguage)
+	if checkPolicy.Valid {
+		login.CheckPolicy, login.CheckExpiration = &checkPolicy.Bool, &checkExpiration.Bool
+	}
+	if defaultDatabase != "" {
+		login.Options["default_database"] = defaultDatabase
+	}
+	if defaultLanguage != "" {
+		login.Options["default_language"] = defaultLanguage
+	}
+	return login, nil
+}
+
+// CreateLogin creates the SQL login with its password, SID and options, and disables it when requested
+func (c *Connector) CreateLogin(ctx context.Context, login *model.Login) error {
+	stmtSQL := "CREATE LOGIN " + quoteIdentifier(login.Name)
+	options := loginOptions(login)
+	if login.Sid != "" {
+		// validated by the schema, a binary literal cannot be a parameter of CREATE LOGIN
+		options = append([]string{"SID = " + login.Sid}, options...)
+	}
+	if login.Password != "" {
+		password := "PASSWORD = " + quoteString(login.Password)
+		if login.MustChange {
+			password += " MUST_CHANGE"
+		}
+		options = append([]string{password}, options...)
+	}
+	for opt := range login.Options {
+		if isLoginArgumentOption(login, opt) {
+			continue
+		}
+		options = append(options, fmt.Sprintf("%s = %s", opt, login.Options[opt].ValueOrSqlNull()))
+	}
+	if len(options) > 0 {
+		stmtSQL += " WITH " + strings.Join(options, ", ")
+	}
+	if err := c.ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	if login.Disabled {
+		return c.SetLoginDisabled(ctx, login.Name, true)
+	}
+	return nil
+}
+
+// AlterLoginOptions sets the default database and language and the password policy of the login,
+// left as is when empty or nil
+func (c *Connector) AlterLoginOptions(ctx context.Context, login *model.Login) error {
+	return c.AlterLogin(ctx, login.Name, loginOptions(login))
+}
+
+// loginOptions returns the options of the login set by its arguments, in CREATE LOGIN or ALTER LOGIN syntax
+func loginOptions(login *model.Login) []string {
+	options := loginDefaultOptions(login.DefaultDatabase, login.DefaultLanguage)
+	// CHECK_POLICY must be ON when CHECK_EXPIRATION is, so it goes first
+	if login.CheckPolicy != nil {
+		options = append(options, "CHECK_POLICY = "+onOff(*login.CheckPolicy))
+	}
+	if login.CheckExpiration != nil {
+		options = append(options, "CHECK_EXPIRATION = "+onOff(*login.CheckExpiration))
+	}
+	return options
+}
+
+// isLoginArgumentOption tells whether the option is also set by an argument, which takes precedence
+func isLoginArgumentOption(login *model.Login, option string) bool {
+	switch strings.ToLower(option) {
+	case "default_database":
+		return login.DefaultDatabase != ""
+	case "default_language":
+		return login.DefaultLanguage != ""
+	}
+	return false
+}
+
+// AlterLogin sets the options of the login, e.g. DEFAULT_DATABASE = [db]
+func (c *Connector) AlterLogin(ctx context.Context, name string, options []string) error {
+	if len(options) == 0 {
+		return nil
+	}
+	stmtSQL := fmt.Sprintf("ALTER LOGIN %s WITH %s", quoteIdentifier(name), strings.Join(options, ", "))
+	return c.ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) RenameLogin(ctx context.Context, oldName string, newName string) error {
+	stmtSQL := fmt.Sprintf("ALTER LOGIN %s WITH NAME = %s", quoteIdentifier(oldName), quoteIdentifier(newName))
+	return c.ExecContext(ctx, stmtSQL)
+}
+
+// DropLogin drops the login if it exists
+func (c *Connector) DropLogin(ctx context.Context, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [master].[sys].[server_principals] WHERE [name] = @name) DROP LOGIN %s",
+		quoteIdentifier(name))
+	return c.ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+// UnlockLogin unlocks the SQL login locked out by the password policy. Without password, the policy is turned off
+// and on again, which unlocks the login too, CHECK_EXPIRATION being turned off meanwhile as it requires the policy.
+func (c *Connector) UnlockLogin(ctx context.Context, name string, password string, checkExpiration bool) error {
+	login := quoteIdentifier(name)
+	if password != "" {
+		stmtSQL := fmt.Sprintf("ALTER LOGIN %s WITH PASSWORD = %s UNLOCK", login, quoteString(password))
+		return c.ExecContext(ctx, stmtSQL)
+	}
+
+	statements := []string{
+		fmt.Sprintf("ALTER LOGIN %s WITH CHECK_POLICY = OFF", login),
+		fmt.Sprintf("ALTER LOGIN %s WITH CHECK_POLICY = ON", login),
+	}
+	if checkExpiration {
+		statements = append([]string{fmt.Sprintf("ALTER LOGIN %s WITH CHECK_EXPIRATION = OFF", login)}, statements...)
+		statements = append(statements, fmt.Sprintf("ALTER LOGIN %s WITH CHECK_EXPIRATION = ON", login))
+	}
+	return c.ExecContext(ctx, strings.Join(statements, "; "))
+}
@@ -0,0 +1,75 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetMasterKey reads the master key of the database. Returns nil when the database has none.
+func (c *Connector) GetMasterKey(ctx context.Context, database string) (*model.MasterKey, error) {
+	stmtSQL := `SELECT LOWER(CONVERT(nvarchar(36), k.key_guid)), CONVERT(varchar(33), k.create_date, 126), d.is_master_key_encrypted_by_server
+		FROM [sys].[symmetric_keys] k, [sys].[databases] d
+		WHERE k.name = '##MS_DatabaseMasterKey##' AND d.database_id = DB_ID()`
+
+	key := &model.MasterKey{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&key.KeyGuid, &key.CreatedAt, &key.EncryptedByServer)
+		})
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// CreateMasterKey creates the master key encrypted by the password, and by the service master key unless disabled
+func (c *Connector) CreateMasterKey(ctx context.Context, key *model.MasterKey) error {
+	statements := []string{"CREATE MASTER KEY"}
+	if key.Password != "" {
+		statements[0] += " ENCRYPTION BY PASSWORD = " + quoteString(key.Password)
+	}
+	if !key.EncryptedByServer {
+		statements = append(statements, "ALTER MASTER KEY DROP ENCRYPTION BY SERVICE MASTER KEY")
+	}
+	return c.setDatabase(key.Database).ExecContext(ctx, strings.Join(statements, "; "))
+}
+
+// AlterMasterKey regenerates the master key or replaces its password encryption, and adds or drops the
+// encryption by the service master key. The key is opened with the old password, as it is not opened
+// automatically when not encrypted by the service master key.
+func (c *Connector) AlterMasterKey(ctx context.Context, key *model.MasterKey, oldPassword string, regenerate bool, serverChanged bool) error {
+	statements := make([]string, 0, 5)
+	if oldPassword != "" {
+		statements = append(statements, "OPEN MASTER KEY DECRYPTION BY PASSWORD = "+quoteString(oldPassword))
+	}
+	if regenerate {
+		statements = append(statements, "ALTER MASTER KEY REGENERATE WITH ENCRYPTION BY PASSWORD = "+quoteString(key.Password))
+	} else if key.Password != oldPassword {
+		if key.Password != "" {
+			statements = append(statements, "ALTER MASTER KEY ADD ENCRYPTION BY PASSWORD = "+quoteString(key.Password))
+		}
+		if oldPassword != "" {
+			statements = append(statements, "ALTER MASTER KEY DROP ENCRYPTION BY PASSWORD = "+quoteString(oldPassword))
+		}
+	}
+	if serverChanged && key.EncryptedByServer {
+		statements = append(statements, "ALTER MASTER KEY ADD ENCRYPTION BY SERVICE MASTER KEY")
+	} else if serverChanged {
+		statements = append(statements, "ALTER MASTER KEY DROP ENCRYPTION BY SERVICE MASTER KEY")
+	}
+	if oldPassword != "" {
+		statements = append(statements, "CLOSE MASTER KEY")
+	}
+	return c.setDatabase(key.Database).ExecContext(ctx, strings.Join(statements, "; "))
+}
+
+func (c *Connector) DropMasterKey(ctx context.Context, database string) error {
+	stmtSQL := "IF EXISTS (SELECT 1 FROM [sys].[symmetric_keys] WHERE name = '##MS_DatabaseMasterKey##') DROP MASTER KEY"
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
@@ -0,0 +1,96 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetObjectPermission reads the permission of the principal on the object, or on its columns when the permission
+// is not granted on the whole object. Returns nil when the permission is neither granted nor denied.
+func (c *Connector) GetObjectPermission(ctx context.Context, database string, schema string, object string, principal string, permission string) (*model.ObjectPermission, error) {
+	stmtSQL := `SELECT dp.state_desc, COALESCE(c.name, '')
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = dp.grantee_principal_id
+			LEFT JOIN [sys].[columns] c ON c.object_id = dp.major_id AND c.column_id = dp.minor_id
+		WHERE dp.class = 1 AND dp.major_id = OBJECT_ID(@object) AND p.name = @principal AND dp.permission_name = @permission
+		ORDER BY dp.minor_id`
+
+	var states, columns []string
+	err := c.setDatabase(database).
+		QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
+			for rows.Next() {
+				var state, column string
+				if err := rows.Scan(&state, &column); err != nil {
+					return err
+				}
+				states, columns = append(states, state), append(columns, column)
+			}
+			return rows.Err()
+		}, sql.Named("object", quoteIdentifier(schema)+"."+quoteIdentifier(object)),
+			sql.Named("principal", principal), sql.Named("permission", permission))
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, nil
+	}
+
+	p := &model.ObjectPermission{Schema: schema, Object: object, Columns: make([]string, 0)}
+	p.Database, p.Principal, p.Permission, p.State = database, principal, permission, states[0]
+	if columns[0] != "" {
+		// column permissions only, all of them are expected in the same state
+		p.Columns = columns
+	}
+	if p.State == "GRANT_WITH_GRANT_OPTION" {
+		p.State, p.WithGrantOption = model.PermissionStateGrant, true
+	}
+	return p, nil
+}
+
+// SetObjectPermission grants or denies the permission on the object or on the listed columns
+func (c *Connector) SetObjectPermission(ctx context.Context, p *model.ObjectPermission) error {
+	stmtSQL := fmt.Sprintf("%s %s ON %s TO %s", p.State, p.Permission, objectPermissionTarget(p), quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " WITH GRANT OPTION"
+	}
+	return c.setDatabase(p.Database).ExecContext(ctx, stmtSQL)
+}
+
+// RevokeObjectPermission removes the grant or deny of the permission on the object or on the listed columns
+func (c *Connector) RevokeObjectPermission(ctx context.Context, p *model.ObjectPermission) error {
+	stmtSQL := fmt.Sprintf("REVOKE %s ON %s FROM %s", p.Permission, objectPermissionTarget(p), quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " CASCADE"
+	}
+	return c.setDatabase(p.Database).ExecContext(ctx, stmtSQL)
+}
+
+func objectPermissionTarget(p *model.ObjectPermission) string {
+	target := fmt.Sprintf("OBJECT::%s.%s", quoteIdentifier(p.Schema), quoteIdentifier(p.Object))
+	if len(p.Columns) > 0 {
+		columns := make([]string, len(p.Columns))
+		for i, column := range p.Columns {
+			columns[i] = quoteIdentifier(column)
+		}
+		target += " (" + strings.Join(columns, ", ") + ")"
+	}
+	return target
+}
+
+// GetObjectPermissionGrantees lists the principals the permission on the object or its columns is granted or denied
+// to, depending on state, but system principals
+func (c *Connector) GetObjectPermissionGrantees(ctx context.Context, database string, schema string, object string, permission string, state string) ([]string, error) {
+	stmtSQL := `SELECT DISTINCT p.name
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = dp.grantee_principal_id
+		WHERE dp.class = 1 AND dp.major_id = OBJECT_ID(@object) AND dp.permission_name = @permission AND ` + permissionStateSQL + `
+			AND NOT ` + systemDatabasePrincipalSQL + `
+		ORDER BY p.name`
+	return c.setDatabase(database).queryStrings(ctx, stmtSQL,
+		sql.Named("object", quoteIdentifier(schema)+"."+quoteIdentifier(object)), sql.Named("permission", permission),
+		sql.Named("state", state))
+}
@@ -0,0 +1,138 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// typeNameSQL formats the type of the column or parameter aliased %[1]s with its length, precision or scale,
+// e.g. nvarchar(50), varchar(max) or decimal(18, 2). Alias types are named without them.
+const typeNameSQL = `TYPE_NAME(%[1]s.user_type_id) + CASE
+		WHEN %[1]s.user_type_id <> %[1]s.system_type_id THEN ''
+		WHEN TYPE_NAME(%[1]s.system_type_id) IN ('binary', 'char', 'varbinary', 'varchar') THEN '(' + IIF(%[1]s.max_length = -1, 'max', CAST(%[1]s.max_length AS varchar)) + ')'
+		WHEN TYPE_NAME(%[1]s.system_type_id) IN ('nchar', 'nvarchar') THEN '(' + IIF(%[1]s.max_length = -1, 'max', CAST(%[1]s.max_length / 2 AS varchar)) + ')'
+		WHEN TYPE_NAME(%[1]s.system_type_id) IN ('decimal', 'numeric') THEN '(' + CAST(%[1]s.precision AS varchar) + ', ' + CAST(%[1]s.scale AS varchar) + ')'
+		WHEN TYPE_NAME(%[1]s.system_type_id) IN ('datetime2', 'datetimeoffset', 'time') THEN '(' + CAST(%[1]s.scale AS varchar) + ')'
+		ELSE '' END`
+
+// boundaryText converts a boundary value to the text read back from sys.partition_range_values
+const boundaryText = "CONVERT(nvarchar(4000), %s, 126)"
+
+// GetPartitionFunction looks the partition function up by name, with its input type and boundary values.
+// Returns nil when the function does not exist.
+func (c *Connector) GetPartitionFunction(ctx context.Context, database, name string) (*model.PartitionFunction, error) {
+	stmtSQL := `SELECT pf.function_id, pf.name, IIF(pf.boundary_value_on_right = 1, 'RIGHT', 'LEFT'), ` + fmt.Sprintf(typeNameSQL, "pp") + `
+		FROM [sys].[partition_functions] pf
+			JOIN [sys].[partition_parameters] pp ON pp.function_id = pf.function_id AND pp.parameter_id = 1
+		WHERE pf.name = @name`
+
+	var id int
+	function := &model.PartitionFunction{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&id, &function.Name, &function.Range, &function.InputType)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	function.Boundaries, err = connector.queryStrings(ctx, fmt.Sprintf(`SELECT %s FROM [sys].[partition_range_values]
+		WHERE function_id = @id ORDER BY boundary_id`, fmt.Sprintf(boundaryText, "value")), sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+	return function, nil
+}
+
+// NormalizePartitionBoundaries converts the values to the input type, then to the text read back for boundary values,
+// e.g. 2024-01-01T00:00:00 for 2024-01-01 as datetime
+func (c *Connector) NormalizePartitionBoundaries(ctx context.Context, database, inputType string, values []string) ([]string, error) {
+	stmtSQL := "SELECT " + fmt.Sprintf(boundaryText, "CAST(CAST(@value AS "+inputType+") AS sql_variant)")
+	connector := c.setDatabase(database)
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		var text string
+		err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&text)
+		}, sql.Named("value", value))
+		if err != nil {
+			return nil, fmt.Errorf("convert boundary value %s to %s: %w", value, inputType, err)
+		}
+		normalized = append(normalized, text)
+	}
+	return normalized, nil
+}
+
+func (c *Connector) CreatePartitionFunction(ctx context.Context, function *model.PartitionFunction) error {
+	values := make([]string, 0, len(function.Boundaries))
+	for _, value := range function.Boundaries {
+		values = append(values, boundaryLiteral(function.InputType, value))
+	}
+	stmtSQL := fmt.Sprintf("CREATE PARTITION FUNCTION %s (%s) AS RANGE %s FOR VALUES (%s)",
+		quoteIdentifier(function.Name), function.InputType, function.Range, strings.Join(values, ", "))
+	return c.setDatabase(function.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterPartitionFunction splits the ranges at the boundary values added to old, setting the next used filegroup of the
+// partition schemes of the function first when given, then merges the ranges at the removed boundary values
+func (c *Connector) AlterPartitionFunction(ctx context.Context, function *model.PartitionFunction, old []string) error {
+	oldValues, err := c.NormalizePartitionBoundaries(ctx, function.Database, function.InputType, old)
+	if err != nil {
+		return err
+	}
+	values, err := c.NormalizePartitionBoundaries(ctx, function.Database, function.InputType, function.Boundaries)
+	if err != nil {
+		return err
+	}
+
+	connector := c.setDatabase(function.Database)
+	name := quoteIdentifier(function.Name)
+	for _, value := range values {
+		if containsString(oldValues, value) {
+			continue
+		}
+		if function.NextUsedFilegroup != "" {
+			stmtSQL := fmt.Sprintf(`DECLARE @schemes nvarchar(max) = '';
+				SELECT @schemes += 'ALTER PARTITION SCHEME ' + QUOTENAME(ps.name) + ' NEXT USED ' + %s + ';'
+					FROM [sys].[partition_schemes] ps
+						JOIN [sys].[partition_functions] pf ON pf.function_id = ps.function_id
+					WHERE pf.name = @name;
+				EXEC (@schemes)`, quoteString(quoteIdentifier(function.NextUsedFilegroup)))
+			if err := connector.ExecContext(ctx, stmtSQL, sql.Named("name", function.Name)); err != nil {
+				return err
+			}
+		}
+		stmtSQL := fmt.Sprintf("ALTER PARTITION FUNCTION %s() SPLIT RANGE (%s)", name, boundaryLiteral(function.InputType, value))
+		if err := connector.ExecContext(ctx, stmtSQL); err != nil {
+			return err
+		}
+	}
+	for _, value := range oldValues {
+		if containsString(values, value) {
+			continue
+		}
+		stmtSQL := fmt.Sprintf("ALTER PARTITION FUNCTION %s() MERGE RANGE (%s)", name, boundaryLiteral(function.InputType, value))
+		if err := connector.ExecContext(ctx, stmtSQL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeletePartitionFunction drops the function, which must not be used by partition schemes
+func (c *Connector) DeletePartitionFunction(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[partition_functions] WHERE name = @name)
+		DROP PARTITION FUNCTION %s`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+func boundaryLiteral(inputType, value string) string {
+	return fmt.Sprintf("CAST(N%s AS %s)", quoteString(value), inputType)
+}
@@ -0,0 +1,65 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetPartitionScheme looks the partition scheme up by name, with its function and the filegroups of its partitions,
+// including the next used one. Returns nil when the scheme does not exist.
+func (c *Connector) GetPartitionScheme(ctx context.Context, database, name string) (*model.PartitionScheme, error) {
+	stmtSQL := `SELECT ps.data_space_id, ps.name, pf.name
+		FROM [sys].[partition_schemes] ps
+			JOIN [sys].[partition_functions] pf ON pf.function_id = ps.function_id
+		WHERE ps.name = @name`
+
+	var id int
+	scheme := &model.PartitionScheme{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&id, &scheme.Name, &scheme.Function)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scheme.Filegroups, err = connector.queryStrings(ctx, `SELECT fg.name
+		FROM [sys].[destination_data_spaces] dds
+			JOIN [sys].[filegroups] fg ON fg.data_space_id = dds.data_space_id
+		WHERE dds.partition_scheme_id = @id
+		ORDER BY dds.destination_id`, sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+func (c *Connector) CreatePartitionScheme(ctx context.Context, scheme *model.PartitionScheme) error {
+	var destination string
+	if scheme.AllTo != "" {
+		destination = fmt.Sprintf("ALL TO (%s)", quoteIdentifier(scheme.AllTo))
+	} else {
+		filegroups := make([]string, 0, len(scheme.Filegroups))
+		for _, fg := range scheme.Filegroups {
+			filegroups = append(filegroups, quoteIdentifier(fg))
+		}
+		destination = fmt.Sprintf("TO (%s)", strings.Join(filegroups, ", "))
+	}
+	stmtSQL := fmt.Sprintf("CREATE PARTITION SCHEME %s AS PARTITION %s %s",
+		quoteIdentifier(scheme.Name), quoteIdentifier(scheme.Function), destination)
+	return c.setDatabase(scheme.Database).ExecContext(ctx, stmtSQL)
+}
+
+// DeletePartitionScheme drops the scheme, which must not be used by tables or indexes
+func (c *Connector) DeletePartitionScheme(ctx context.Context, database, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[partition_schemes] WHERE name = @name)
+		DROP PARTITION SCHEME %s`, quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
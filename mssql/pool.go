@@ -0,0 +1,111 @@
+package mssql
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// dbPool keeps one *sql.DB per database and connection settings for the life of the provider,
+// so that statements reuse pooled connections instead of dialing and logging in every time.
+// The driver resets pooled sessions (sp_reset_connection) before reusing them.
+type dbPool struct {
+	mu  sync.Mutex
+	dbs map[string]*sql.DB
+
+	// sessions holds the session IDs of the open connections of the pool, which are never killed.
+	// It has its own lock, as connections are closed while mu is held by ReleaseDatabase.
+	sessionsMu sync.Mutex
+	sessions   map[int]bool
+}
+
+// poolMu guards the creation of the pool of a connector
+var poolMu sync.Mutex
+
+// sharedPool returns the pool of the connector, creating it on first use.
+// setDatabase copies the pointer, so the provider connector and its copies share one pool.
+func (c *Connector) sharedPool() *dbPool {
+	poolMu.Lock()
+	defer poolMu.Unlock()
+	if c.pool == nil {
+		c.pool = &dbPool{dbs: map[string]*sql.DB{}, sessions: map[int]bool{}}
+	}
+	return c.pool
+}
+
+func (c *Connector) poolKey() string {
+	if c.DSN != "" {
+		server := c.Host + ":" + strconv.Itoa(c.Port) + "/" + c.Instance
+		if c.Login != nil {
+			server = c.Login.Username + ":" + c.Login.Password + "@" + server
+		}
+		return c.Database + "\x00" + server + "\x00" + c.DSN
+	}
+	return c.Database + "\x00" + c.ConnectionString()
+}
+
+// get returns the pooled DB of the key, opening it on first use. The DB is opened without holding the lock, as
+// connecting retries until the connection timeout and must not block the other keys. When two callers open the same
+// key concurrently, the first one stored wins and the other DB is closed.
+func (p *dbPool) get(key string, open func() (*sql.DB, error)) (*sql.DB, error) {
+	p.mu.Lock()
+	db, ok := p.dbs[key]
+	p.mu.Unlock()
+	if ok {
+		return db, nil
+	}
+
+	opened, err := open()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if db, ok := p.dbs[key]; ok {
+		opened.Close()
+		return db, nil
+	}
+	p.dbs[key] = opened
+	return opened, nil
+}
+
+// ReleaseDatabase closes the pooled connections bound to the database, which would otherwise
+// keep it in use and fail DROP DATABASE
+func (c *Connector) ReleaseDatabase(database string) {
+	p := c.sharedPool()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	prefix := database + "\x00"
+	for key, db := range p.dbs {
+		if strings.HasPrefix(key, prefix) {
+			db.Close()
+			delete(p.dbs, key)
+		}
+	}
+}
+
+func (p *dbPool) addSession(id int) {
+	p.sessionsMu.Lock()
+	defer p.sessionsMu.Unlock()
+	p.sessions[id] = true
+}
+
+func (p *dbPool) removeSession(id int) {
+	p.sessionsMu.Lock()
+	defer p.sessionsMu.Unlock()
+	delete(p.sessions, id)
+}
+
+// sessionIDs lists the session IDs of the open connections of the pool
+func (p *dbPool) sessionIDs() []int {
+	p.sessionsMu.Lock()
+	defer p.sessionsMu.Unlock()
+	ids := make([]int, 0, len(p.sessions))
+	for id := range p.sessions {
+		ids = append(ids, id)
+	}
+	return ids
+}
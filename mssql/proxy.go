@@ -0,0 +1,99 @@
+package mssql
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/proxy"
+)
+
+// proxyDialer dials the server through a SOCKS5 or HTTP CONNECT proxy, with the server host resolved by the proxy
+type proxyDialer struct {
+	proxy     *url.URL
+	target    string
+	keepAlive time.Duration
+}
+
+func (d proxyDialer) DialContext(ctx context.Context, network string, _ string) (net.Conn, error) {
+	if network != "tcp" {
+		return nil, errors.Errorf("proxy: %s connections are not supported, named instances need an explicit port", network)
+	}
+
+	switch d.proxy.Scheme {
+	case "socks5", "socks5h":
+		dialer, err := proxy.FromURL(d.proxy, &net.Dialer{KeepAlive: d.keepAlive})
+		if err != nil {
+			return nil, err
+		}
+		if cd, ok := dialer.(proxy.ContextDialer); ok {
+			return cd.DialContext(ctx, "tcp", d.target)
+		}
+		return dialer.Dial("tcp", d.target)
+
+	case "http", "https":
+		return httpConnect(ctx, d.proxy, d.target, d.keepAlive)
+	}
+	return nil, errors.Errorf("proxy: unsupported scheme %q, expected socks5, socks5h, http or https", d.proxy.Scheme)
+}
+
+// httpConnect opens a tunnel to target with the CONNECT method
+func httpConnect(ctx context.Context, proxyURL *url.URL, target string, keepAlive time.Duration) (net.Conn, error) {
+	address := proxyURL.Host
+	if proxyURL.Port() == "" {
+		port := "80"
+		if proxyURL.Scheme == "https" {
+			port = "443"
+		}
+		address = net.JoinHostPort(proxyURL.Hostname(), port)
+	}
+
+	dialer := net.Dialer{KeepAlive: keepAlive}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "proxy: connecting to %s", address)
+	}
+	if proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+		if deadline, ok := ctx.Deadline(); ok {
+			tlsConn.SetDeadline(deadline)
+			defer tlsConn.SetDeadline(time.Time{})
+		}
+		if err := tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "proxy: TLS handshake with %s", address)
+		}
+		conn = tlsConn
+	}
+
+	request := fmt.Sprintf("CONNECT %s HTTP/1.1\r\nHost: %s\r\n", target, target)
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		request += "Proxy-Authorization: Basic " + credentials + "\r\n"
+	}
+	if _, err := conn.Write([]byte(request + "\r\n")); err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "proxy: sending CONNECT")
+	}
+
+	// the server does not send anything before the client prelogin, so nothing past the headers is buffered
+	response, err := http.ReadResponse(bufio.NewReader(conn), &http.Request{Method: http.MethodConnect})
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "proxy: reading CONNECT response")
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, errors.Errorf("proxy: CONNECT %s refused: %s", target, response.Status)
+	}
+	return conn, nil
+}
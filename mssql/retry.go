@@ -0,0 +1,145 @@
+package mssql
+
+import (
+	stderrors "errors"
+	"log"
+	"math/rand"
+	"time"
+
+	mssql "github.com/denisenkom/go-mssqldb"
+	"github.com/pkg/errors"
+)
+
+const (
+	initialRetryDelay = 250 * time.Millisecond
+	maxRetryDelay     = 10 * time.Second
+)
+
+// SQL Server error numbers of login failures, which retrying does not fix
+var loginErrors = map[int32]bool{
+	18452: true, // login from an untrusted domain
+	18456: true, // login failed
+	18470: true, // account disabled
+	18486: true, // account locked out
+	18487: true, // password expired
+	18488: true, // password must be changed
+}
+
+// SQL Server error numbers of transient Azure SQL failures, during which the database is briefly
+// unavailable (reconfiguration, failover, throttling, serverless resume)
+var transientErrors = map[int32]bool{
+	4060:  true, // cannot open database requested by the login
+	4221:  true, // login to read-secondary failed due to long wait on HADR_DATABASE_WAIT_FOR_TRANSITION_TO_VERSIONING
+	10928: true, // resource limit reached
+	10929: true, // resource limit reached, minimum guarantee
+	40197: true, // service error processing the request
+	40501: true, // service is currently busy
+	40613: true, // database not currently available, e.g. paused serverless database resuming
+	49918: true, // not enough resources to process the request
+	49919: true, // too many create or update operations in progress
+	49920: true, // too many operations in progress
+}
+
+// SQL Server error numbers of lock conflicts, the statement can be run again once the other transaction is done
+var lockErrors = map[int32]bool{
+	1205: true, // chosen as deadlock victim
+	1222: true, // lock request time out period exceeded
+}
+
+// serverlessResumingError is returned while a paused serverless database is resuming
+const serverlessResumingError = 40613
+
+// tokenError marks failures to acquire an Azure AD token, which retrying does not fix either
+type tokenError struct {
+	err error
+}
+
+func (e *tokenError) Error() string {
+	return e.err.Error()
+}
+
+func (e *tokenError) Unwrap() error {
+	return e.err
+}
+
+// backoff retries an operation with exponential delays and jitter,
+// until it succeeds, fails with a permanent error, or runs out of attempts or time
+type backoff struct {
+	timeout     time.Duration
+	maxAttempts int
+}
+
+func (c *Connector) connectBackoff() backoff {
+	return backoff{timeout: c.Timeout, maxAttempts: c.MaxConnectAttempts}
+}
+
+func (b backoff) run(what string, op func() error, retryable func(error) bool) error {
+	deadline := time.Now().Add(b.timeout)
+	delay := initialRetryDelay
+	for attempt := 1; ; attempt++ {
+		err := op()
+		if err == nil || !retryable(err) {
+			return err
+		}
+		if b.maxAttempts > 0 && attempt >= b.maxAttempts {
+			return errors.Wrapf(err, "%s failed after %d attempts", what, attempt)
+		}
+
+		// full jitter within the upper half of the delay, so that parallel operations spread out
+		sleep := delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+		if time.Now().Add(sleep).After(deadline) {
+			return errors.Wrapf(err, "%s failed after %s timeout", what, b.timeout)
+		}
+		log.Printf("[WARN] %s failed (attempt %d), retrying in %s: %s", what, attempt, sleep.Round(time.Millisecond), err)
+		time.Sleep(sleep)
+
+		if delay *= 2; delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+// sqlErrorNumber returns the number of the SQL Server error, or 0 if err does not come from the server
+func sqlErrorNumber(err error) int32 {
+	var sqlErr mssql.Error
+	if stderrors.As(err, &sqlErr) {
+		return sqlErr.Number
+	}
+	return 0
+}
+
+// isRetryableConnectError tells whether a connection attempt may succeed later,
+// e.g. network failures while the server is starting, as opposed to rejected credentials
+func isRetryableConnectError(err error) bool {
+	var tokenErr *tokenError
+	if stderrors.As(err, &tokenErr) {
+		return false
+	}
+	number := sqlErrorNumber(err)
+	if number == serverlessResumingError {
+		log.Printf("[INFO] Database is not currently available, waiting for it to resume")
+	}
+	return !loginErrors[number]
+}
+
+// isTransientError tells whether a statement failed because of a transient Azure SQL condition
+// and can be run again
+func isTransientError(err error) bool {
+	return transientErrors[sqlErrorNumber(err)]
+}
+
+// statementRetryable returns the retry decision for one statement: transient errors are retried
+// until the backoff gives up, deadlocks and lock timeouts at most DeadlockRetries times
+func (c *Connector) statementRetryable() func(error) bool {
+	lockConflicts := 0
+	return func(err error) bool {
+		if isTransientError(err) {
+			return true
+		}
+		if lockErrors[sqlErrorNumber(err)] && lockConflicts < c.DeadlockRetries {
+			lockConflicts++
+			return true
+		}
+		return false
+	}
+}
@@ -0,0 +1,68 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetSchemaPermission reads the permission of the principal on the schema.
+// Returns nil when the permission is neither granted nor denied.
+func (c *Connector) GetSchemaPermission(ctx context.Context, database string, schema string, principal string, permission string) (*model.SchemaPermission, error) {
+	stmtSQL := `SELECT dp.state_desc
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = dp.grantee_principal_id
+		WHERE dp.class = 3 AND dp.major_id = SCHEMA_ID(@schema) AND p.name = @principal AND dp.permission_name = @permission`
+
+	var state string
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&state)
+		}, sql.Named("schema", schema), sql.Named("principal", principal), sql.Named("permission", permission))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := &model.SchemaPermission{Schema: schema}
+	p.Database, p.Principal, p.Permission, p.State = database, principal, permission, state
+	if state == "GRANT_WITH_GRANT_OPTION" {
+		p.State, p.WithGrantOption = model.PermissionStateGrant, true
+	}
+	return p, nil
+}
+
+// SetSchemaPermission grants or denies the permission on the schema
+func (c *Connector) SetSchemaPermission(ctx context.Context, p *model.SchemaPermission) error {
+	stmtSQL := fmt.Sprintf("%s %s ON SCHEMA::%s TO %s", p.State, p.Permission, quoteIdentifier(p.Schema), quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " WITH GRANT OPTION"
+	}
+	return c.setDatabase(p.Database).ExecContext(ctx, stmtSQL)
+}
+
+// RevokeSchemaPermission removes the grant or deny of the permission on the schema
+func (c *Connector) RevokeSchemaPermission(ctx context.Context, p *model.SchemaPermission) error {
+	stmtSQL := fmt.Sprintf("REVOKE %s ON SCHEMA::%s FROM %s", p.Permission, quoteIdentifier(p.Schema), quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " CASCADE"
+	}
+	return c.setDatabase(p.Database).ExecContext(ctx, stmtSQL)
+}
+
+// GetSchemaPermissionGrantees lists the principals the permission on the schema is granted or denied to,
+// depending on state, but system principals
+func (c *Connector) GetSchemaPermissionGrantees(ctx context.Context, database string, schema string, permission string, state string) ([]string, error) {
+	stmtSQL := `SELECT p.name
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] p ON p.principal_id = dp.grantee_principal_id
+		WHERE dp.class = 3 AND dp.major_id = SCHEMA_ID(@schema) AND dp.permission_name = @permission AND ` + permissionStateSQL + `
+			AND NOT ` + systemDatabasePrincipalSQL + `
+		ORDER BY p.name`
+	return c.setDatabase(database).queryStrings(ctx, stmtSQL, sql.Named("schema", schema), sql.Named("permission", permission),
+		sql.Named("state", state))
+}
@@ -0,0 +1,133 @@
+package mssql
+
+import (
+	"context"
+	"database/sql/driver"
+	"log"
+	"sync"
+
+	mssql "github.com/denisenkom/go-mssqldb"
+)
+
+// SecretSource reads the password of a login from an external secret store
+type SecretSource interface {
+	ReadSecret() (string, error)
+}
+
+// rotatingSecret caches the value of a secret source, read again when the server rejects it,
+// i.e. after the secret has been rotated
+type rotatingSecret struct {
+	mu     sync.Mutex
+	source SecretSource
+	value  string
+	read   bool
+}
+
+// secretMu guards the creation of the rotating secret of a login
+var secretMu sync.Mutex
+
+// secret returns the rotating secret of the login, creating it on first use.
+// The login is shared by the connector copies, so is the secret.
+func (l *LoginUser) secret() *rotatingSecret {
+	secretMu.Lock()
+	defer secretMu.Unlock()
+	if l.rotating == nil {
+		l.rotating = &rotatingSecret{source: l.PasswordSource}
+	}
+	return l.rotating
+}
+
+func (s *rotatingSecret) get() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.read {
+		value, err := s.source.ReadSecret()
+		if err != nil {
+			return "", err
+		}
+		s.value, s.read = value, true
+	}
+	return s.value, nil
+}
+
+// refresh reads the secret again if it still is the rejected value, and tells whether it changed
+func (s *rotatingSecret) refresh(rejected string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.value != rejected {
+		// already refreshed by a concurrent connection
+		return true, nil
+	}
+	value, err := s.source.ReadSecret()
+	if err != nil {
+		return false, err
+	}
+	s.value = value
+	return value != rejected, nil
+}
+
+// rotatingConnector logs in with the current value of the password source,
+// and reads it again once when the login fails
+type rotatingConnector struct {
+	c *Connector
+
+	mu   sync.Mutex
+	last driver.Connector
+}
+
+func (r *rotatingConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	secret := r.c.Login.secret()
+	password, err := secret.get()
+	if err != nil {
+		return nil, &tokenError{err: err}
+	}
+
+	conn, err := r.connect(ctx, password)
+	if sqlErrorNumber(err) != 18456 {
+		return conn, err
+	}
+	changed, readErr := secret.refresh(password)
+	if readErr != nil {
+		return nil, &tokenError{err: readErr}
+	}
+	if !changed {
+		return nil, err
+	}
+	log.Printf("[INFO] Login failed, retrying with the rotated password")
+	password, _ = secret.get()
+	return r.connect(ctx, password)
+}
+
+func (r *rotatingConnector) connect(ctx context.Context, password string) (driver.Conn, error) {
+	conn := *r.c
+	login := *r.c.Login
+	login.Password = password
+	conn.Login = &login
+
+	connector, err := mssql.NewConnector(conn.ConnectionString())
+	if err != nil {
+		return nil, err
+	}
+	if dialer := conn.dialer(); dialer != nil {
+		connector.Dialer = dialer
+	}
+
+	r.mu.Lock()
+	r.last = connector
+	r.mu.Unlock()
+	return connector.Connect(ctx)
+}
+
+func (r *rotatingConnector) Driver() driver.Driver {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.last == nil {
+		// all the driver connectors share the driver registered as "sqlserver"
+		connector, err := mssql.NewConnector(r.c.ConnectionString())
+		if err != nil {
+			return nil
+		}
+		return connector.Driver()
+	}
+	return r.last.Driver()
+}
@@ -0,0 +1,67 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetSensitivityClassification reads the classification of the column. Returns nil when the column is not classified.
+func (c *Connector) GetSensitivityClassification(ctx context.Context, database string, schema string, table string, column string) (*model.SensitivityClassification, error) {
+	stmtSQL := `SELECT ISNULL(CONVERT(nvarchar(128), label), ''), ISNULL(LOWER(CONVERT(nvarchar(36), label_id)), ''),
+			ISNULL(CONVERT(nvarchar(128), information_type), ''), ISNULL(LOWER(CONVERT(nvarchar(36), information_type_id)), ''),
+			ISNULL(rank_desc, '')
+		FROM [sys].[sensitivity_classifications]
+		WHERE class = 1 AND major_id = OBJECT_ID(@table) AND minor_id = COLUMNPROPERTY(OBJECT_ID(@table), @column, 'ColumnId')`
+
+	classification := &model.SensitivityClassification{Database: database, Schema: schema, Table: table, Column: column}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&classification.Label, &classification.LabelID, &classification.InformationType,
+				&classification.InformationTypeID, &classification.Rank)
+		}, sql.Named("table", quoteIdentifier(schema)+"."+quoteIdentifier(table)), sql.Named("column", column))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return classification, nil
+}
+
+// SetSensitivityClassification classifies the column, replacing its current classification
+func (c *Connector) SetSensitivityClassification(ctx context.Context, classification *model.SensitivityClassification) error {
+	options := make([]string, 0, 5)
+	if classification.Label != "" {
+		options = append(options, "LABEL = "+quoteString(classification.Label))
+	}
+	if classification.LabelID != "" {
+		options = append(options, "LABEL_ID = "+quoteString(classification.LabelID))
+	}
+	if classification.InformationType != "" {
+		options = append(options, "INFORMATION_TYPE = "+quoteString(classification.InformationType))
+	}
+	if classification.InformationTypeID != "" {
+		options = append(options, "INFORMATION_TYPE_ID = "+quoteString(classification.InformationTypeID))
+	}
+	if classification.Rank != "" {
+		options = append(options, "RANK = "+classification.Rank)
+	}
+
+	stmtSQL := fmt.Sprintf("ADD SENSITIVITY CLASSIFICATION TO %s WITH (%s)",
+		sensitivityClassificationColumn(classification), strings.Join(options, ", "))
+	return c.setDatabase(classification.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DropSensitivityClassification(ctx context.Context, classification *model.SensitivityClassification) error {
+	stmtSQL := "DROP SENSITIVITY CLASSIFICATION FROM " + sensitivityClassificationColumn(classification)
+	return c.setDatabase(classification.Database).ExecContext(ctx, stmtSQL)
+}
+
+func sensitivityClassificationColumn(classification *model.SensitivityClassification) string {
+	return fmt.Sprintf("%s.%s.%s", quoteIdentifier(classification.Schema), quoteIdentifier(classification.Table),
+		quoteIdentifier(classification.Column))
+}
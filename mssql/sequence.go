@@ -0,0 +1,95 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetSequence looks the sequence up by schema and name, with its options and current value.
+// Returns nil when the sequence does not exist.
+func (c *Connector) GetSequence(ctx context.Context, database, schema, name string) (*model.Sequence, error) {
+	stmtSQL := `SELECT SCHEMA_NAME(s.schema_id), s.name, TYPE_NAME(s.user_type_id),
+			CAST(s.start_value AS bigint), CAST(s.increment AS bigint), CAST(s.minimum_value AS bigint), CAST(s.maximum_value AS bigint),
+			s.is_cycling, s.is_cached, ISNULL(s.cache_size, 0), CAST(s.current_value AS bigint)
+		FROM [sys].[sequences] s
+		WHERE s.schema_id = SCHEMA_ID(@schema) AND s.name = @name`
+
+	var start, min, max int64
+	sequence := &model.Sequence{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&sequence.Schema, &sequence.Name, &sequence.DataType, &start, &sequence.Increment, &min, &max,
+				&sequence.Cycle, &sequence.Cached, &sequence.CacheSize, &sequence.CurrentValue)
+		}, sql.Named("schema", schema), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sequence.Start, sequence.MinValue, sequence.MaxValue = &start, &min, &max
+	return sequence, nil
+}
+
+func (c *Connector) CreateSequence(ctx context.Context, sequence *model.Sequence) error {
+	options := []string{fmt.Sprintf("INCREMENT BY %d", sequence.Increment)}
+	if sequence.Start != nil {
+		options = append(options, fmt.Sprintf("START WITH %d", *sequence.Start))
+	}
+	options = append(options, sequenceOptions(sequence)...)
+	stmtSQL := fmt.Sprintf("CREATE SEQUENCE %s.%s AS %s %s", quoteIdentifier(sequence.Schema), quoteIdentifier(sequence.Name),
+		sequence.DataType, strings.Join(options, " "))
+	return c.setDatabase(sequence.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterSequence sets the options of the sequence, restarting it at Start when restart is set
+func (c *Connector) AlterSequence(ctx context.Context, sequence *model.Sequence, restart bool) error {
+	options := []string{fmt.Sprintf("INCREMENT BY %d", sequence.Increment)}
+	if restart && sequence.Start != nil {
+		options = append(options, fmt.Sprintf("RESTART WITH %d", *sequence.Start))
+	} else if restart {
+		options = append(options, "RESTART")
+	}
+	options = append(options, sequenceOptions(sequence)...)
+	stmtSQL := fmt.Sprintf("ALTER SEQUENCE %s.%s %s", quoteIdentifier(sequence.Schema), quoteIdentifier(sequence.Name),
+		strings.Join(options, " "))
+	return c.setDatabase(sequence.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteSequence(ctx context.Context, database, schema, name string) error {
+	stmtSQL := fmt.Sprintf("DROP SEQUENCE IF EXISTS %s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+// sequenceOptions are the range, cycle and cache options shared by CREATE and ALTER SEQUENCE
+func sequenceOptions(sequence *model.Sequence) []string {
+	options := make([]string, 0)
+	if sequence.MinValue != nil {
+		options = append(options, fmt.Sprintf("MINVALUE %d", *sequence.MinValue))
+	} else {
+		options = append(options, "NO MINVALUE")
+	}
+	if sequence.MaxValue != nil {
+		options = append(options, fmt.Sprintf("MAXVALUE %d", *sequence.MaxValue))
+	} else {
+		options = append(options, "NO MAXVALUE")
+	}
+	if sequence.Cycle {
+		options = append(options, "CYCLE")
+	} else {
+		options = append(options, "NO CYCLE")
+	}
+	switch {
+	case !sequence.Cached:
+		options = append(options, "NO CACHE")
+	case sequence.CacheSize > 0:
+		options = append(options, fmt.Sprintf("CACHE %d", sequence.CacheSize))
+	default:
+		options = append(options, "CACHE")
+	}
+	return options
+}
@@ -0,0 +1,21 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Values of SERVERPROPERTY('EngineEdition')
+const (
+	EngineEditionAzureSQLDatabase     = 5
+	EngineEditionAzureManagedInstance = 8
+)
+
+// GetEngineEdition returns SERVERPROPERTY('EngineEdition') of the connected server
+func (c *Connector) GetEngineEdition(ctx context.Context) (int, error) {
+	var edition int
+	err := c.QueryRowContext(ctx, "SELECT CAST(SERVERPROPERTY('EngineEdition') AS int)", func(row *sql.Row) error {
+		return row.Scan(&edition)
+	})
+	return edition, err
+}
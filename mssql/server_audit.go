@@ -0,0 +1,126 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// unlimitedRolloverFiles is the max_rollover_files of sys.server_file_audits for UNLIMITED
+const unlimitedRolloverFiles = 2147483647
+
+// GetServerAudit looks the audit up by name. Returns nil when the audit does not exist.
+func (c *Connector) GetServerAudit(ctx context.Context, name string) (*model.ServerAudit, error) {
+	stmtSQL := `SELECT LOWER(CONVERT(nvarchar(36), a.audit_guid)), a.name, REPLACE(a.type_desc, ' ', '_'),
+			ISNULL(f.log_file_path, ''), ISNULL(f.max_file_size, 0), ISNULL(f.max_rollover_files, 0), ISNULL(f.reserve_disk_space, 0),
+			a.queue_delay, CASE a.on_failure WHEN 1 THEN 'SHUTDOWN' WHEN 2 THEN 'FAIL_OPERATION' ELSE 'CONTINUE' END,
+			ISNULL(a.predicate, ''), a.is_state_enabled
+		FROM [sys].[server_audits] a
+			LEFT JOIN [sys].[server_file_audits] f ON f.audit_id = a.audit_id
+		WHERE a.name = @name`
+
+	audit := new(model.ServerAudit)
+	err := c.setDatabase("master").
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&audit.AuditGuid, &audit.Name, &audit.Target, &audit.Path, &audit.MaxSizeMB, &audit.MaxRolloverFiles,
+				&audit.ReserveDiskSpace, &audit.QueueDelay, &audit.OnFailure, &audit.Predicate, &audit.Enabled)
+		}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if audit.MaxRolloverFiles == unlimitedRolloverFiles {
+		audit.MaxRolloverFiles = 0
+	}
+	return audit, nil
+}
+
+// CreateServerAudit creates the audit, and enables it unless disabled
+func (c *Connector) CreateServerAudit(ctx context.Context, audit *model.ServerAudit) error {
+	stmtSQL := fmt.Sprintf("CREATE SERVER AUDIT %s %s", quoteIdentifier(audit.Name), serverAuditOptions(audit))
+	if audit.Predicate != "" {
+		stmtSQL += " WHERE " + audit.Predicate
+	}
+	if err := c.setDatabase("master").ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	if !audit.Enabled {
+		return nil
+	}
+	return c.SetServerAuditState(ctx, audit.Name, true)
+}
+
+// AlterServerAudit sets the target, options and predicate of the audit, which must be disabled meanwhile
+func (c *Connector) AlterServerAudit(ctx context.Context, audit *model.ServerAudit) error {
+	if err := c.SetServerAuditState(ctx, audit.Name, false); err != nil {
+		return err
+	}
+
+	stmtSQL := fmt.Sprintf("ALTER SERVER AUDIT %s %s", quoteIdentifier(audit.Name), serverAuditOptions(audit))
+	if audit.Predicate != "" {
+		stmtSQL += " WHERE " + audit.Predicate
+	} else {
+		stmtSQL += "; ALTER SERVER AUDIT " + quoteIdentifier(audit.Name) + " REMOVE WHERE"
+	}
+	if err := c.setDatabase("master").ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	if !audit.Enabled {
+		return nil
+	}
+	return c.SetServerAuditState(ctx, audit.Name, true)
+}
+
+func (c *Connector) SetServerAuditState(ctx context.Context, name string, enabled bool) error {
+	stmtSQL := fmt.Sprintf("ALTER SERVER AUDIT %s WITH (STATE = %s)", quoteIdentifier(name), onOff(enabled))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+// DeleteServerAudit disables the audit before dropping it, as enabled audits cannot be dropped
+func (c *Connector) DeleteServerAudit(ctx context.Context, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[server_audits] WHERE [name] = @name)
+		BEGIN
+			ALTER SERVER AUDIT %[1]s WITH (STATE = OFF)
+			DROP SERVER AUDIT %[1]s
+		END`, quoteIdentifier(name))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+func serverAuditOptions(audit *model.ServerAudit) string {
+	var target string
+	switch audit.Target {
+	case model.AuditTargetFile:
+		options := []string{"FILEPATH = " + quoteString(audit.Path)}
+		if audit.MaxSizeMB > 0 {
+			options = append(options, fmt.Sprintf("MAXSIZE = %d MB", audit.MaxSizeMB))
+		} else {
+			options = append(options, "MAXSIZE = UNLIMITED")
+		}
+		if audit.MaxRolloverFiles > 0 {
+			options = append(options, fmt.Sprintf("MAX_ROLLOVER_FILES = %d", audit.MaxRolloverFiles))
+		} else {
+			options = append(options, "MAX_ROLLOVER_FILES = UNLIMITED")
+		}
+		if audit.ReserveDiskSpace {
+			options = append(options, "RESERVE_DISK_SPACE = ON")
+		} else {
+			options = append(options, "RESERVE_DISK_SPACE = OFF")
+		}
+		target = fmt.Sprintf("TO FILE (%s)", strings.Join(options, ", "))
+	case model.AuditTargetURL:
+		options := []string{"PATH = " + quoteString(audit.Path)}
+		if audit.RetentionDays > 0 {
+			options = append(options, fmt.Sprintf("RETENTION_DAYS = %d", audit.RetentionDays))
+		}
+		target = fmt.Sprintf("TO URL (%s)", strings.Join(options, ", "))
+	default:
+		target = "TO " + audit.Target
+	}
+
+	return fmt.Sprintf("%s WITH (QUEUE_DELAY = %d, ON_FAILURE = %s)", target, audit.QueueDelay, audit.OnFailure)
+}
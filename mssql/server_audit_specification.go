@@ -0,0 +1,76 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetServerAuditSpecification looks the specification up by name, with its audit and action groups.
+// Returns nil when the specification does not exist.
+func (c *Connector) GetServerAuditSpecification(ctx context.Context, name string) (*model.ServerAuditSpecification, error) {
+	stmtSQL := `SELECT s.server_specification_id, s.name, ISNULL(a.name, ''), s.is_state_enabled
+		FROM [sys].[server_audit_specifications] s
+			LEFT JOIN [sys].[server_audits] a ON a.audit_guid = s.audit_guid
+		WHERE s.name = @name`
+
+	var id int
+	spec := new(model.ServerAuditSpecification)
+	connector := c.setDatabase("master")
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&id, &spec.Name, &spec.Audit, &spec.Enabled)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	spec.ActionGroups, err = connector.queryStrings(ctx, `SELECT audit_action_name FROM [sys].[server_audit_specification_details]
+		WHERE server_specification_id = @id ORDER BY audit_action_name`, sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func (c *Connector) CreateServerAuditSpecification(ctx context.Context, spec *model.ServerAuditSpecification) error {
+	stmtSQL := fmt.Sprintf("CREATE SERVER AUDIT SPECIFICATION %s FOR SERVER AUDIT %s",
+		quoteIdentifier(spec.Name), quoteIdentifier(spec.Audit))
+	if clauses := auditSpecificationClauses(nil, spec.ActionGroups); len(clauses) > 0 {
+		stmtSQL += " " + strings.Join(clauses, ", ")
+	}
+	stmtSQL += " WITH (STATE = " + onOff(spec.Enabled) + ")"
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+// AlterServerAuditSpecification disables the specification, changes its audit, adds and drops the action groups,
+// then sets its state, as enabled specifications cannot be altered
+func (c *Connector) AlterServerAuditSpecification(ctx context.Context, oldGroups []string, spec *model.ServerAuditSpecification) error {
+	name := quoteIdentifier(spec.Name)
+	alter := fmt.Sprintf("ALTER SERVER AUDIT SPECIFICATION %s FOR SERVER AUDIT %s", name, quoteIdentifier(spec.Audit))
+	if clauses := auditSpecificationClauses(oldGroups, spec.ActionGroups); len(clauses) > 0 {
+		alter += " " + strings.Join(clauses, ", ")
+	}
+
+	statements := []string{
+		fmt.Sprintf("ALTER SERVER AUDIT SPECIFICATION %s WITH (STATE = OFF)", name),
+		alter,
+		fmt.Sprintf("ALTER SERVER AUDIT SPECIFICATION %s WITH (STATE = %s)", name, onOff(spec.Enabled)),
+	}
+	return c.setDatabase("master").ExecContext(ctx, strings.Join(statements, "; "))
+}
+
+// DeleteServerAuditSpecification disables the specification before dropping it, as enabled specifications cannot be dropped
+func (c *Connector) DeleteServerAuditSpecification(ctx context.Context, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[server_audit_specifications] WHERE [name] = @name)
+		BEGIN
+			ALTER SERVER AUDIT SPECIFICATION %[1]s WITH (STATE = OFF)
+			DROP SERVER AUDIT SPECIFICATION %[1]s
+		END`, quoteIdentifier(name))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,71 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetServerPermission reads the server scope permission of the login or server role.
+// Returns nil when the permission is neither granted nor denied.
+func (c *Connector) GetServerPermission(ctx context.Context, principal string, permission string) (*model.ServerPermissionGrant, error) {
+	stmtSQL := `SELECT sp.state_desc
+		FROM [master].[sys].[server_permissions] sp
+			INNER JOIN [master].[sys].[server_principals] p ON p.principal_id = sp.grantee_principal_id
+		WHERE sp.class = 100 AND p.name = @principal AND sp.permission_name = @permission`
+
+	var state string
+	err := c.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&state)
+	}, sql.Named("principal", principal), sql.Named("permission", permission))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p := &model.ServerPermissionGrant{Principal: principal, Permission: permission, State: state}
+	if state == "GRANT_WITH_GRANT_OPTION" {
+		p.State, p.WithGrantOption = model.PermissionStateGrant, true
+	}
+	return p, nil
+}
+
+// SetServerPermission grants or denies the server scope permission
+func (c *Connector) SetServerPermission(ctx context.Context, p *model.ServerPermissionGrant) error {
+	stmtSQL := fmt.Sprintf("%s %s TO %s", p.State, p.Permission, quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " WITH GRANT OPTION"
+	}
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+// RevokeServerPermission removes the grant or deny of the server scope permission,
+// with the permissions granted further by the principal when it had the grant option
+func (c *Connector) RevokeServerPermission(ctx context.Context, p *model.ServerPermissionGrant) error {
+	stmtSQL := fmt.Sprintf("REVOKE %s FROM %s", p.Permission, quoteIdentifier(p.Principal))
+	if p.WithGrantOption {
+		stmtSQL += " CASCADE"
+	}
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+// systemServerPrincipalSQL matches the server principals p the server refuses or should not be asked to change:
+// sa, public, the fixed roles, the ##MS_...## certificate logins and the service accounts
+const systemServerPrincipalSQL = `(p.principal_id < 3 OR p.is_fixed_role = 1 OR p.name LIKE '##MS[_]%##'
+	OR p.name LIKE 'NT SERVICE\%' OR p.name LIKE 'NT AUTHORITY\%')`
+
+// GetServerPermissionGrantees lists the logins and server roles the server scope permission is granted or denied
+// to, depending on state, but system principals
+func (c *Connector) GetServerPermissionGrantees(ctx context.Context, permission string, state string) ([]string, error) {
+	stmtSQL := `SELECT p.name
+		FROM [master].[sys].[server_permissions] sp
+			INNER JOIN [master].[sys].[server_principals] p ON p.principal_id = sp.grantee_principal_id
+		WHERE sp.class = 100 AND sp.permission_name = @permission AND (CASE sp.state WHEN 'D' THEN 'DENY' ELSE 'GRANT' END) = @state
+			AND NOT ` + systemServerPrincipalSQL + `
+		ORDER BY p.name`
+	return c.queryStrings(ctx, stmtSQL, sql.Named("permission", permission), sql.Named("state", state))
+}
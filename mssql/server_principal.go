@@ -0,0 +1,120 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetServerPrincipalPermissions reads server role memberships and explicit server permissions of the login.
+// Azure SQL Database has no server scope, so the roles and permissions of the matching user in master are read instead.
+func (c *Connector) GetServerPrincipalPermissions(ctx context.Context, name string) (*model.ServerPrincipalPermissions, error) {
+	edition, err := c.GetEngineEdition(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if edition == EngineEditionAzureSQLDatabase {
+		return c.getMasterPrincipalPermissions(ctx, name)
+	}
+
+	master := c.setDatabase("master")
+	principal := &model.ServerPrincipalPermissions{Scope: model.PermissionScopeServer}
+	stmtSQL := "SELECT principal_id, name, type_desc FROM [sys].[server_principals] WHERE [name] = @name AND type != 'R'"
+	err = master.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&principal.PrincipalID, &principal.Name, &principal.Type)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("login '%s' not found", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stmtSQL = `SELECT r.name
+		FROM [sys].[server_role_members] rm
+			INNER JOIN [sys].[server_principals] r ON r.principal_id = rm.role_principal_id
+		WHERE rm.member_principal_id = @principal_id
+		ORDER BY r.name`
+	principal.Roles, err = master.queryStrings(ctx, stmtSQL, sql.Named("principal_id", principal.PrincipalID))
+	if err != nil {
+		return nil, err
+	}
+
+	stmtSQL = `SELECT permission_name, state_desc
+		FROM [sys].[server_permissions]
+		WHERE grantee_principal_id = @principal_id
+		ORDER BY permission_name`
+	principal.Permissions, err = master.queryServerPermissions(ctx, stmtSQL, sql.Named("principal_id", principal.PrincipalID))
+	if err != nil {
+		return nil, err
+	}
+
+	return principal, nil
+}
+
+func (c *Connector) getMasterPrincipalPermissions(ctx context.Context, name string) (*model.ServerPrincipalPermissions, error) {
+	master := c.setDatabase("master")
+	principal := &model.ServerPrincipalPermissions{Scope: model.PermissionScopeMasterDatabase}
+
+	var sid []byte
+	stmtSQL := "SELECT principal_id, name, type_desc, sid FROM [sys].[sql_logins] WHERE [name] = @name"
+	err := master.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&principal.PrincipalID, &principal.Name, &principal.Type, &sid)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("login '%s' not found", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Server admin of the logical server is mapped to dbo in master
+	stmtSQL = "SELECT CAST(COUNT(*) AS bit) FROM [sys].[database_principals] WHERE [name] = 'dbo' AND sid = @sid"
+	err = master.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&principal.IsServerAdmin)
+	}, sql.Named("sid", sid))
+	if err != nil {
+		return nil, err
+	}
+
+	stmtSQL = `SELECT r.name
+		FROM [sys].[database_role_members] rm
+			INNER JOIN [sys].[database_principals] r ON r.principal_id = rm.role_principal_id
+			INNER JOIN [sys].[database_principals] m ON m.principal_id = rm.member_principal_id
+		WHERE m.sid = @sid
+		ORDER BY r.name`
+	principal.Roles, err = master.queryStrings(ctx, stmtSQL, sql.Named("sid", sid))
+	if err != nil {
+		return nil, err
+	}
+
+	stmtSQL = `SELECT dp.permission_name, dp.state_desc
+		FROM [sys].[database_permissions] dp
+			INNER JOIN [sys].[database_principals] m ON m.principal_id = dp.grantee_principal_id
+		WHERE m.sid = @sid AND dp.class = 0
+		ORDER BY dp.permission_name`
+	principal.Permissions, err = master.queryServerPermissions(ctx, stmtSQL, sql.Named("sid", sid))
+	if err != nil {
+		return nil, err
+	}
+
+	return principal, nil
+}
+
+func (c *Connector) queryServerPermissions(ctx context.Context, query string, args ...interface{}) ([]model.ServerPermission, error) {
+	permissions := make([]model.ServerPermission, 0)
+	err := c.QueryContext(ctx, query, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var permission model.ServerPermission
+			if err := rows.Scan(&permission.Permission, &permission.State); err != nil {
+				return err
+			}
+			permissions = append(permissions, permission)
+		}
+		return rows.Err()
+	}, args...)
+	return permissions, err
+}
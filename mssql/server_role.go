@@ -0,0 +1,105 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetServerRole looks the server role up by SID, or by name when SID is empty.
+// Returns nil when the role does not exist.
+func (c *Connector) GetServerRole(ctx context.Context, sid string, name string) (*model.ServerRole, error) {
+	stmtSQL := `SELECT r.principal_id, CONVERT(varchar(172), r.sid, 1), r.name, COALESCE(o.name, ''), r.is_fixed_role
+		FROM [master].[sys].[server_principals] r
+			LEFT JOIN [master].[sys].[server_principals] o ON o.principal_id = r.owning_principal_id
+		WHERE r.type = 'R' AND `
+	if sid != "" {
+		stmtSQL += "r.sid = CONVERT(varbinary(85), @sid, 1)"
+	} else {
+		stmtSQL += "r.name = @name"
+	}
+
+	role := &model.ServerRole{}
+	err := c.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&role.PrincipalID, &role.Sid, &role.Name, &role.Owner, &role.IsFixedRole)
+	}, sql.Named("sid", sid), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// CreateServerRole creates the user-defined server role, which SQL Server supports since 2012
+func (c *Connector) CreateServerRole(ctx context.Context, role *model.ServerRole) error {
+	edition, err := c.GetEngineEdition(ctx)
+	if err != nil {
+		return err
+	}
+	if edition == EngineEditionAzureSQLDatabase {
+		return fmt.Errorf("server role %s: Azure SQL Database has no server roles", role.Name)
+	}
+
+	stmtSQL := "CREATE SERVER ROLE " + quoteIdentifier(role.Name)
+	if role.Owner != "" {
+		stmtSQL += " AUTHORIZATION " + quoteIdentifier(role.Owner)
+	}
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) RenameServerRole(ctx context.Context, oldName string, newName string) error {
+	stmtSQL := fmt.Sprintf("ALTER SERVER ROLE %s WITH NAME = %s", quoteIdentifier(oldName), quoteIdentifier(newName))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) AlterServerRoleOwner(ctx context.Context, role string, owner string) error {
+	stmtSQL := fmt.Sprintf("ALTER AUTHORIZATION ON SERVER ROLE::%s TO %s", quoteIdentifier(role), quoteIdentifier(owner))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteServerRole(ctx context.Context, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[server_principals] WHERE type = 'R' AND [name] = @name) DROP SERVER ROLE %s",
+		quoteIdentifier(name))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+// IsServerRoleMember checks whether the login or server role is a direct member of the server role
+func (c *Connector) IsServerRoleMember(ctx context.Context, role string, member string) (bool, error) {
+	stmtSQL := `SELECT COUNT(*)
+		FROM [master].[sys].[server_role_members] rm
+			INNER JOIN [master].[sys].[server_principals] r ON r.principal_id = rm.role_principal_id
+			INNER JOIN [master].[sys].[server_principals] m ON m.principal_id = rm.member_principal_id
+		WHERE r.name = @role AND m.name = @member`
+
+	var count int
+	err := c.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&count)
+	}, sql.Named("role", role), sql.Named("member", member))
+	return count > 0, err
+}
+
+func (c *Connector) AddServerRoleMember(ctx context.Context, role string, member string) error {
+	stmtSQL := fmt.Sprintf("ALTER SERVER ROLE %s ADD MEMBER %s", quoteIdentifier(role), quoteIdentifier(member))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DropServerRoleMember(ctx context.Context, role string, member string) error {
+	stmtSQL := fmt.Sprintf("ALTER SERVER ROLE %s DROP MEMBER %s", quoteIdentifier(role), quoteIdentifier(member))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL)
+}
+
+// GetServerRoleMembers lists names of the direct members of the server role, sorted by name.
+// System principals, like sa member of sysadmin, are left out.
+func (c *Connector) GetServerRoleMembers(ctx context.Context, role string) ([]string, error) {
+	stmtSQL := `SELECT p.name
+		FROM [master].[sys].[server_role_members] rm
+			INNER JOIN [master].[sys].[server_principals] r ON r.principal_id = rm.role_principal_id
+			INNER JOIN [master].[sys].[server_principals] p ON p.principal_id = rm.member_principal_id
+		WHERE r.name = @role AND NOT ` + systemServerPrincipalSQL + `
+		ORDER BY p.name`
+	return c.queryStrings(ctx, stmtSQL, sql.Named("role", role))
+}
@@ -0,0 +1,94 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// triggerHeader matches the CREATE TRIGGER header of a DDL trigger definition, up to the AS preceding the body
+var triggerHeader = regexp.MustCompile(`(?is)^\s*(CREATE|ALTER)\s+(OR\s+ALTER\s+)?TRIGGER\s+.+?\s+ON\s+(ALL\s+SERVER|DATABASE)\s+(WITH\s+.+?\s+)?(FOR|AFTER)\s+[\w\s,]+?\s+AS\b`)
+
+// triggerBody extracts the body from the definition of a DDL trigger, returns false when it cannot be parsed
+func triggerBody(definition string) (string, bool) {
+	header := triggerHeader.FindString(definition)
+	if header == "" {
+		return "", false
+	}
+	return strings.TrimSpace(definition[len(header):]), true
+}
+
+// triggerStatement builds the CREATE or ALTER statement of the DDL trigger on scope, ALL SERVER or DATABASE
+func triggerStatement(verb, name, scope string, events []string, body string) string {
+	return fmt.Sprintf("%s TRIGGER %s ON %s FOR %s AS\n%s", verb, quoteIdentifier(name), scope,
+		strings.Join(events, ", "), strings.TrimSpace(body))
+}
+
+// GetServerTrigger looks the server DDL trigger up by name, with its events and definition.
+// Returns nil when the trigger does not exist.
+func (c *Connector) GetServerTrigger(ctx context.Context, name string) (*model.ServerTrigger, error) {
+	stmtSQL := `SELECT t.object_id, t.name, t.is_disabled, ISNULL(m.definition, '')
+		FROM [sys].[server_triggers] t
+			LEFT JOIN [sys].[server_sql_modules] m ON m.object_id = t.object_id
+		WHERE t.name = @name AND t.parent_class = 100`
+
+	var id int
+	var disabled bool
+	trigger := new(model.ServerTrigger)
+	connector := c.setDatabase("master")
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&id, &trigger.Name, &disabled, &trigger.Definition)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	trigger.Enabled = !disabled
+	trigger.Body, _ = triggerBody(trigger.Definition)
+
+	trigger.Events, err = connector.queryStrings(ctx, `SELECT DISTINCT ISNULL(event_group_type_desc, type_desc)
+		FROM [sys].[server_trigger_events] WHERE object_id = @id`, sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+	return trigger, nil
+}
+
+func (c *Connector) CreateServerTrigger(ctx context.Context, trigger *model.ServerTrigger) error {
+	connector := c.setDatabase("master")
+	err := connector.ExecContext(ctx, triggerStatement("CREATE", trigger.Name, "ALL SERVER", trigger.Events, trigger.Body))
+	if err != nil || trigger.Enabled {
+		return err
+	}
+	return connector.SetServerTriggerEnabled(ctx, trigger.Name, false)
+}
+
+// AlterServerTrigger changes the events and body of the trigger, then sets its state
+func (c *Connector) AlterServerTrigger(ctx context.Context, trigger *model.ServerTrigger) error {
+	connector := c.setDatabase("master")
+	err := connector.ExecContext(ctx, triggerStatement("ALTER", trigger.Name, "ALL SERVER", trigger.Events, trigger.Body))
+	if err != nil {
+		return err
+	}
+	return connector.SetServerTriggerEnabled(ctx, trigger.Name, trigger.Enabled)
+}
+
+func (c *Connector) SetServerTriggerEnabled(ctx context.Context, name string, enabled bool) error {
+	state := "DISABLE"
+	if enabled {
+		state = "ENABLE"
+	}
+	return c.setDatabase("master").ExecContext(ctx, fmt.Sprintf("%s TRIGGER %s ON ALL SERVER", state, quoteIdentifier(name)))
+}
+
+func (c *Connector) DeleteServerTrigger(ctx context.Context, name string) error {
+	stmtSQL := fmt.Sprintf(`IF EXISTS (SELECT 1 FROM [sys].[server_triggers] WHERE [name] = @name AND parent_class = 100)
+		DROP TRIGGER %s ON ALL SERVER`, quoteIdentifier(name))
+	return c.setDatabase("master").ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
@@ -0,0 +1,108 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// How long to wait for killed sessions to disappear from sys.dm_exec_sessions
+const killSessionsTimeout = 30 * time.Second
+
+// ownSessionsFilter leaves out the sessions of the connections opened by this provider, which are never killed.
+// Other sessions with the same application name and host, e.g. of another Terraform run, are killed.
+func (c *Connector) ownSessionsFilter() string {
+	filter := "s.session_id <> @@SPID AND s.is_user_process = 1"
+	ids := c.sharedPool().sessionIDs()
+	if len(ids) == 0 {
+		return filter
+	}
+	sessions := make([]string, len(ids))
+	for i, id := range ids {
+		sessions[i] = strconv.Itoa(id)
+	}
+	return filter + " AND s.session_id NOT IN (" + strings.Join(sessions, ", ") + ")"
+}
+
+// KillLoginSessions kills all sessions of the login
+func (c *Connector) KillLoginSessions(ctx context.Context, name string) error {
+	return c.setDatabase("master").killSessions(ctx, fmt.Sprintf("login '%s'", name),
+		"s.login_name = @name", sql.Named("name", name))
+}
+
+// KillUserSessions kills all sessions connected to the database on behalf of the user
+func (c *Connector) KillUserSessions(ctx context.Context, database, username string) error {
+	return c.setDatabase(database).killSessions(ctx, fmt.Sprintf("user '%s' in database '%s'", username, database),
+		"s.database_id = DB_ID() AND s.security_id IN (SELECT sid FROM [sys].[database_principals] WHERE [name] = @name)",
+		sql.Named("name", username))
+}
+
+// KillDatabaseSessions kills all sessions connected to the database
+func (c *Connector) KillDatabaseSessions(ctx context.Context, database string) error {
+	return c.setDatabase("master").killSessions(ctx, fmt.Sprintf("database '%s'", database),
+		"s.database_id = DB_ID(@database)", sql.Named("database", database))
+}
+
+// killSessions issues KILL for every session matching the filter and waits until they are gone.
+// Failure to kill a single session is logged and does not stop the others.
+func (c *Connector) killSessions(ctx context.Context, owner string, filter string, args ...interface{}) error {
+	stmtSQL := "SELECT s.session_id FROM [sys].[dm_exec_sessions] s WHERE " + filter + " AND " + c.ownSessionsFilter()
+	var sessions []int
+	err := c.QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var sessionId int
+			if err := rows.Scan(&sessionId); err != nil {
+				return err
+			}
+			sessions = append(sessions, sessionId)
+		}
+		return rows.Err()
+	}, args...)
+	if err != nil {
+		return fmt.Errorf("list sessions of %s: %w", owner, err)
+	}
+	if len(sessions) == 0 {
+		log.Printf("[INFO] No sessions to kill for %s", owner)
+		return nil
+	}
+
+	killed := 0
+	for _, sessionId := range sessions {
+		log.Printf("[INFO] Killing session %d of %s", sessionId, owner)
+		err = c.ExecContext(ctx, `DECLARE @stmt nvarchar(50) = 'KILL ' + CAST(@session_id AS nvarchar(20))
+			IF @session_id <> @@SPID EXEC (@stmt)`, sql.Named("session_id", sessionId))
+		if err != nil {
+			log.Printf("[WARN] Failed to kill session %d of %s: %s", sessionId, owner, err)
+			continue
+		}
+		killed++
+	}
+	if killed == 0 {
+		return fmt.Errorf("none of %d sessions of %s could be killed", len(sessions), owner)
+	}
+
+	deadline := time.Now().Add(killSessionsTimeout)
+	for {
+		var remaining int
+		countSQL := "SELECT COUNT(*) FROM [sys].[dm_exec_sessions] s WHERE " + filter + " AND " + c.ownSessionsFilter()
+		err = c.QueryRowContext(ctx, countSQL, func(row *sql.Row) error { return row.Scan(&remaining) }, args...)
+		if err != nil {
+			return err
+		}
+		if remaining == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%d sessions of %s still alive after %s", remaining, owner, killSessionsTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package mssql
+
+import (
+	"regexp"
+	"strings"
+)
+
+// moduleName matches the one or two-part name of modules in their definition, e.g. [dbo].[purge] or dbo.purge
+const moduleName = `(?:\[(?:[^\]]|\]\])+\]|"[^"]+"|[^\s.(\[";]+)(?:\s*\.\s*(?:\[(?:[^\]]|\]\])+\]|"[^"]+"|[^\s.(\[";]+))?`
+
+// moduleOptions matches the WITH options of modules, e.g. WITH SCHEMABINDING, EXECUTE AS OWNER
+const moduleOptions = `(?:\s+WITH\s+((?:EXECUTE\s+AS\s+(?:'[^']*'|\w+)|\w+)(?:\s*,\s*(?:EXECUTE\s+AS\s+(?:'[^']*'|\w+)|\w+))*))?`
+
+// moduleVerb matches the CREATE, ALTER or CREATE OR ALTER verb starting module definitions
+var moduleVerb = regexp.MustCompile(`(?is)^\s*(CREATE\s+OR\s+ALTER|CREATE|ALTER)\s+`)
+
+var executeAsOption = regexp.MustCompile(`(?i)EXECUTE\s+AS\s+('[^']*'|\w+)`)
+
+// sameModuleDefinition compares the definition read back from sys.sql_modules with the statement creating or
+// altering the module, ignoring the verb, which the server may rewrite, and the surrounding whitespace
+func sameModuleDefinition(definition, statement string) bool {
+	return strings.TrimSpace(moduleVerb.ReplaceAllString(definition, "")) ==
+		strings.TrimSpace(moduleVerb.ReplaceAllString(statement, ""))
+}
+
+// executeAs extracts the principal of the EXECUTE AS option from the WITH options of a module, empty for CALLER
+func executeAs(options string) string {
+	match := executeAsOption.FindStringSubmatch(options)
+	if match == nil || strings.EqualFold(match[1], "CALLER") {
+		return ""
+	}
+	if strings.HasPrefix(match[1], "'") {
+		return strings.ReplaceAll(strings.Trim(match[1], "'"), "''", "'")
+	}
+	return strings.ToUpper(match[1])
+}
+
+// executeAsClause builds the EXECUTE AS option of a module, quoting user names
+func executeAsClause(principal string) string {
+	switch strings.ToUpper(principal) {
+	case "CALLER", "SELF", "OWNER":
+		return "EXECUTE AS " + strings.ToUpper(principal)
+	}
+	return "EXECUTE AS " + quoteString(principal)
+}
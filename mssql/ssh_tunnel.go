@@ -0,0 +1,204 @@
+package mssql
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// tunnelLocalHost is given to the driver instead of the server host when connecting through a tunnel
+// or a proxy, so that it does not try to resolve a private host name locally
+const tunnelLocalHost = "127.0.0.1"
+
+// defaultKeepAlive is the keepalive interval of the driver
+const defaultKeepAlive = 30 * time.Second
+
+// SSHTunnel routes the TDS connections through an SSH bastion, with the server host resolved on the bastion side
+type SSHTunnel struct {
+	Host                 string `json:"host"`
+	Port                 int    `json:"port"`
+	User                 string `json:"user"`
+	Password             string `json:"password,omitempty"`
+	PrivateKey           string `json:"private_key,omitempty"`
+	PrivateKeyPassphrase string `json:"private_key_passphrase,omitempty"`
+	UseAgent             bool   `json:"use_agent,omitempty"`
+	// HostKey is the public key of the bastion in authorized_keys format, ~/.ssh/known_hosts is used when empty
+	HostKey               string `json:"host_key,omitempty"`
+	InsecureIgnoreHostKey bool   `json:"insecure_ignore_host_key,omitempty"`
+
+	mu     sync.Mutex
+	client *ssh.Client
+	// agent is the connection to the SSH agent of use_agent, closed with the client
+	agent net.Conn
+}
+
+// tunnelDialer dials the server through the tunnel, whatever address the driver resolved
+type tunnelDialer struct {
+	tunnel    *SSHTunnel
+	target    string
+	keepAlive time.Duration
+}
+
+func (d tunnelDialer) DialContext(ctx context.Context, network string, _ string) (net.Conn, error) {
+	if network != "tcp" {
+		return nil, errors.Errorf("ssh tunnel: %s connections are not supported, named instances need an explicit port", network)
+	}
+	return d.tunnel.dial(ctx, d.target, d.keepAlive)
+}
+
+func (t *SSHTunnel) dial(ctx context.Context, target string, keepAlive time.Duration) (net.Conn, error) {
+	client, err := t.connect(ctx, keepAlive)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := client.Dial("tcp", target)
+	if err != nil {
+		// the bastion may have dropped the session, reconnect on the next attempt
+		t.reset(client)
+		return nil, errors.Wrapf(err, "ssh tunnel: dialing %s from %s", target, t.Host)
+	}
+	return conn, nil
+}
+
+func (t *SSHTunnel) connect(ctx context.Context, keepAlive time.Duration) (*ssh.Client, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.client != nil {
+		return t.client, nil
+	}
+
+	config, agentConn, err := t.clientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	address := net.JoinHostPort(t.Host, strconv.Itoa(t.Port))
+	dialer := net.Dialer{KeepAlive: keepAlive}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		closeAgent(agentConn)
+		return nil, errors.Wrapf(err, "ssh tunnel: connecting to %s", address)
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, address, config)
+	if err != nil {
+		conn.Close()
+		closeAgent(agentConn)
+		return nil, errors.Wrapf(err, "ssh tunnel: handshake with %s", address)
+	}
+	t.client, t.agent = ssh.NewClient(c, chans, reqs), agentConn
+	go t.keepAlive(t.client, keepAlive)
+	return t.client, nil
+}
+
+// keepAlive sends SSH keepalive requests, so that the bastion and the firewalls in between do not drop
+// the idle session, and resets the tunnel once the bastion stops answering
+func (t *SSHTunnel) keepAlive(client *ssh.Client, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultKeepAlive
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if _, _, err := client.SendRequest("keepalive@openssh.com", true, nil); err != nil {
+			log.Printf("[DEBUG] ssh tunnel: keepalive to %s failed, reconnecting on next use: %s", t.Host, err)
+			t.reset(client)
+			return
+		}
+	}
+}
+
+func (t *SSHTunnel) reset(client *ssh.Client) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.client == client {
+		t.client.Close()
+		closeAgent(t.agent)
+		t.client, t.agent = nil, nil
+	}
+}
+
+func closeAgent(conn net.Conn) {
+	if conn != nil {
+		conn.Close()
+	}
+}
+
+// clientConfig returns the configuration of the SSH client, and the connection to the SSH agent when use_agent is set
+func (t *SSHTunnel) clientConfig() (*ssh.ClientConfig, net.Conn, error) {
+	hostKeyCallback, err := t.hostKeyCallback()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var auth []ssh.AuthMethod
+	if t.PrivateKey != "" {
+		var signer ssh.Signer
+		if t.PrivateKeyPassphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(t.PrivateKey), []byte(t.PrivateKeyPassphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey([]byte(t.PrivateKey))
+		}
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "ssh tunnel: parsing private key")
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	var agentConn net.Conn
+	if t.UseAgent {
+		socket := os.Getenv("SSH_AUTH_SOCK")
+		if socket == "" {
+			return nil, nil, errors.New("ssh tunnel: use_agent is set but SSH_AUTH_SOCK is not")
+		}
+		agentConn, err = net.Dial("unix", socket)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "ssh tunnel: connecting to the SSH agent")
+		}
+		auth = append(auth, ssh.PublicKeysCallback(agent.NewClient(agentConn).Signers))
+	}
+	if t.Password != "" {
+		auth = append(auth, ssh.Password(t.Password))
+	}
+	if len(auth) == 0 {
+		return nil, nil, errors.New("ssh tunnel: one of private_key, use_agent or password is required")
+	}
+
+	return &ssh.ClientConfig{
+		User:            t.User,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	}, agentConn, nil
+}
+
+func (t *SSHTunnel) hostKeyCallback() (ssh.HostKeyCallback, error) {
+	if t.InsecureIgnoreHostKey {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+	if t.HostKey != "" {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(t.HostKey))
+		if err != nil {
+			return nil, errors.Wrap(err, "ssh tunnel: parsing host_key")
+		}
+		return ssh.FixedHostKey(key), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, errors.Wrap(err, "ssh tunnel: locating known_hosts, set host_key instead")
+	}
+	path := filepath.Join(home, ".ssh", "known_hosts")
+	if _, err := ioutil.ReadFile(path); err != nil {
+		return nil, errors.Wrap(err, "ssh tunnel: reading known_hosts, set host_key instead")
+	}
+	return knownhosts.New(path)
+}
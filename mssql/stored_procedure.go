@@ -0,0 +1,69 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// procedureHeader matches the CREATE PROCEDURE header of a procedure definition, capturing the parameters and
+// the WITH options, up to the AS preceding the body
+var procedureHeader = regexp.MustCompile(`(?is)^\s*(?:CREATE|ALTER)\s+(?:OR\s+ALTER\s+)?PROC(?:EDURE)?\s+` + moduleName +
+	`(.*?)` + moduleOptions + `\s+AS\b`)
+
+// GetStoredProcedure looks the procedure up by schema and name, with its definition and the parameters, options and
+// body parsed from it. Returns nil when the procedure does not exist.
+func (c *Connector) GetStoredProcedure(ctx context.Context, database, schema, name string) (*model.StoredProcedure, error) {
+	stmtSQL := `SELECT SCHEMA_NAME(p.schema_id), p.name, ISNULL(m.definition, '')
+		FROM [sys].[procedures] p
+			LEFT JOIN [sys].[sql_modules] m ON m.object_id = p.object_id
+		WHERE p.schema_id = SCHEMA_ID(@schema) AND p.name = @name`
+
+	procedure := &model.StoredProcedure{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&procedure.Schema, &procedure.Name, &procedure.Definition)
+		}, sql.Named("schema", schema), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if match := procedureHeader.FindStringSubmatch(procedure.Definition); match != nil {
+		procedure.Parameters = strings.TrimSpace(match[1])
+		procedure.ExecuteAs = executeAs(match[2])
+		procedure.Body = strings.TrimSpace(procedure.Definition[len(match[0]):])
+	}
+	return procedure, nil
+}
+
+// SameProcedureDefinition tells whether the definition read back from the server is the one created by the procedure
+func SameProcedureDefinition(procedure *model.StoredProcedure, definition string) bool {
+	return sameModuleDefinition(definition, procedureStatement(procedure))
+}
+
+// CreateOrAlterStoredProcedure creates the procedure, or alters it keeping its permissions
+func (c *Connector) CreateOrAlterStoredProcedure(ctx context.Context, procedure *model.StoredProcedure) error {
+	return c.setDatabase(procedure.Database).ExecContext(ctx, procedureStatement(procedure))
+}
+
+func (c *Connector) DeleteStoredProcedure(ctx context.Context, database, schema, name string) error {
+	stmtSQL := fmt.Sprintf("DROP PROCEDURE IF EXISTS %s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func procedureStatement(procedure *model.StoredProcedure) string {
+	stmtSQL := fmt.Sprintf("CREATE OR ALTER PROCEDURE %s.%s", quoteIdentifier(procedure.Schema), quoteIdentifier(procedure.Name))
+	if procedure.Parameters != "" {
+		stmtSQL += " " + strings.TrimSpace(procedure.Parameters)
+	}
+	if procedure.ExecuteAs != "" {
+		stmtSQL += " WITH " + executeAsClause(procedure.ExecuteAs)
+	}
+	return stmtSQL + " AS\n" + strings.TrimSpace(procedure.Body)
+}
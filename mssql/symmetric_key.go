@@ -0,0 +1,135 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetSymmetricKey looks the key of the database up by name, with the certificates and keys encrypting it.
+// Returns nil when the key does not exist.
+func (c *Connector) GetSymmetricKey(ctx context.Context, database string, name string) (*model.SymmetricKey, error) {
+	stmtSQL := `SELECT symmetric_key_id, name, algorithm_desc, LOWER(CONVERT(nvarchar(36), key_guid))
+		FROM [sys].[symmetric_keys] WHERE [name] = @name`
+
+	key := &model.SymmetricKey{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&key.SymmetricKeyID, &key.Name, &key.Algorithm, &key.KeyGuid)
+	}, sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// key_encryptions identifies certificates and asymmetric keys by thumbprint, symmetric keys by GUID
+	key.Certificates, err = connector.queryStrings(ctx, `SELECT c.name FROM [sys].[key_encryptions] e
+		JOIN [sys].[certificates] c ON c.thumbprint = e.thumbprint
+		WHERE e.key_id = @id AND e.crypt_type = 'ESKC'`, sql.Named("id", key.SymmetricKeyID))
+	if err != nil {
+		return nil, err
+	}
+	key.AsymmetricKeys, err = connector.queryStrings(ctx, `SELECT a.name FROM [sys].[key_encryptions] e
+		JOIN [sys].[asymmetric_keys] a ON a.thumbprint = e.thumbprint
+		WHERE e.key_id = @id AND e.crypt_type = 'ESKA'`, sql.Named("id", key.SymmetricKeyID))
+	if err != nil {
+		return nil, err
+	}
+	key.SymmetricKeys, err = connector.queryStrings(ctx, `SELECT s.name FROM [sys].[key_encryptions] e
+		JOIN [sys].[symmetric_keys] s ON s.key_guid = CONVERT(uniqueidentifier, e.thumbprint)
+		WHERE e.key_id = @id AND e.crypt_type = 'ESKS'`, sql.Named("id", key.SymmetricKeyID))
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (c *Connector) CreateSymmetricKey(ctx context.Context, key *model.SymmetricKey) error {
+	options := []string{"ALGORITHM = " + key.Algorithm}
+	if key.KeySource != "" {
+		options = append(options, "KEY_SOURCE = "+quoteString(key.KeySource))
+	}
+	if key.IdentityValue != "" {
+		options = append(options, "IDENTITY_VALUE = "+quoteString(key.IdentityValue))
+	}
+
+	stmtSQL := fmt.Sprintf("CREATE SYMMETRIC KEY %s WITH %s ENCRYPTION BY %s", quoteIdentifier(key.Name),
+		strings.Join(options, ", "), strings.Join(symmetricKeyEncryptions(key), ", "))
+	return c.setDatabase(key.Database).ExecContext(ctx, stmtSQL)
+}
+
+// AlterSymmetricKey adds the new encryptions of the key before dropping the old ones, as the key cannot lose
+// all its encryptions. The key is opened with the old password, or else one of the old certificates or asymmetric keys.
+func (c *Connector) AlterSymmetricKey(ctx context.Context, oldKey *model.SymmetricKey, key *model.SymmetricKey) error {
+	oldEncryptions := symmetricKeyEncryptions(oldKey)
+	newEncryptions := symmetricKeyEncryptions(key)
+	name := quoteIdentifier(key.Name)
+
+	statements := make([]string, 0)
+	for _, encryption := range newEncryptions {
+		if !containsString(oldEncryptions, encryption) {
+			statements = append(statements, fmt.Sprintf("ALTER SYMMETRIC KEY %s ADD ENCRYPTION BY %s", name, encryption))
+		}
+	}
+	for _, encryption := range oldEncryptions {
+		if !containsString(newEncryptions, encryption) {
+			statements = append(statements, fmt.Sprintf("ALTER SYMMETRIC KEY %s DROP ENCRYPTION BY %s", name, encryption))
+		}
+	}
+	if len(statements) == 0 {
+		return nil
+	}
+
+	var decryption string
+	switch {
+	case oldKey.Password != "":
+		decryption = "PASSWORD = " + quoteString(oldKey.Password)
+	case len(oldKey.Certificates) > 0:
+		decryption = "CERTIFICATE " + quoteIdentifier(oldKey.Certificates[0])
+	case len(oldKey.AsymmetricKeys) > 0:
+		decryption = "ASYMMETRIC KEY " + quoteIdentifier(oldKey.AsymmetricKeys[0])
+	default:
+		return fmt.Errorf("symmetric key '%s' can only be opened by symmetric keys, cannot alter its encryptions", key.Name)
+	}
+	statements = append([]string{fmt.Sprintf("OPEN SYMMETRIC KEY %s DECRYPTION BY %s", name, decryption)}, statements...)
+	statements = append(statements, "CLOSE SYMMETRIC KEY "+name)
+
+	return c.setDatabase(key.Database).ExecContext(ctx, strings.Join(statements, "; "))
+}
+
+func (c *Connector) DeleteSymmetricKey(ctx context.Context, database string, name string) error {
+	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [sys].[symmetric_keys] WHERE [name] = @name) DROP SYMMETRIC KEY %s",
+		quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL, sql.Named("name", name))
+}
+
+func symmetricKeyEncryptions(key *model.SymmetricKey) []string {
+	encryptions := make([]string, 0)
+	for _, certificate := range key.Certificates {
+		encryptions = append(encryptions, "CERTIFICATE "+quoteIdentifier(certificate))
+	}
+	for _, symmetricKey := range key.SymmetricKeys {
+		encryptions = append(encryptions, "SYMMETRIC KEY "+quoteIdentifier(symmetricKey))
+	}
+	for _, asymmetricKey := range key.AsymmetricKeys {
+		encryptions = append(encryptions, "ASYMMETRIC KEY "+quoteIdentifier(asymmetricKey))
+	}
+	if key.Password != "" {
+		encryptions = append(encryptions, "PASSWORD = "+quoteString(key.Password))
+	}
+	return encryptions
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetSynonym looks the synonym up by schema and name, with the parts of the name of its base object.
+// Returns nil when the synonym does not exist.
+func (c *Connector) GetSynonym(ctx context.Context, database, schema, name string) (*model.Synonym, error) {
+	stmtSQL := `SELECT SCHEMA_NAME(s.schema_id), s.name, s.base_object_name,
+			ISNULL(PARSENAME(s.base_object_name, 4), ''), ISNULL(PARSENAME(s.base_object_name, 3), ''),
+			ISNULL(PARSENAME(s.base_object_name, 2), ''), PARSENAME(s.base_object_name, 1)
+		FROM [sys].[synonyms] s
+		WHERE s.schema_id = SCHEMA_ID(@schema) AND s.name = @name`
+
+	synonym := &model.Synonym{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&synonym.Schema, &synonym.Name, &synonym.BaseObjectName,
+				&synonym.BaseServer, &synonym.BaseDatabase, &synonym.BaseSchema, &synonym.BaseObject)
+		}, sql.Named("schema", schema), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return synonym, nil
+}
+
+func (c *Connector) CreateSynonym(ctx context.Context, synonym *model.Synonym) error {
+	parts := make([]string, 0, 4)
+	for _, part := range []string{synonym.BaseServer, synonym.BaseDatabase, synonym.BaseSchema} {
+		if part != "" {
+			parts = append(parts, quoteIdentifier(part))
+		}
+	}
+	parts = append(parts, quoteIdentifier(synonym.BaseObject))
+	stmtSQL := fmt.Sprintf("CREATE SYNONYM %s.%s FOR %s", quoteIdentifier(synonym.Schema), quoteIdentifier(synonym.Name),
+		strings.Join(parts, "."))
+	return c.setDatabase(synonym.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteSynonym(ctx context.Context, database, schema, name string) error {
+	stmtSQL := fmt.Sprintf("DROP SYNONYM IF EXISTS %s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
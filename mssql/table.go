@@ -0,0 +1,327 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetTable looks the table up by schema and name, with its columns in order, its primary key and its unique and
+// check constraints. Returns nil when the table does not exist.
+func (c *Connector) GetTable(ctx context.Context, database, schema, name string) (*model.Table, error) {
+	var id int
+	table := &model.Table{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, `SELECT t.object_id, SCHEMA_NAME(t.schema_id), t.name
+		FROM [sys].[tables] t
+		WHERE t.schema_id = SCHEMA_ID(@schema) AND t.name = @name`, func(row *sql.Row) error {
+		return row.Scan(&id, &table.Schema, &table.Name)
+	}, sql.Named("schema", schema), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stmtSQL := `SELECT c.name, ` + fmt.Sprintf(typeNameSQL, "c") + `, c.is_nullable, c.is_identity,
+			ISNULL(CAST(ic.seed_value AS bigint), 1), ISNULL(CAST(ic.increment_value AS bigint), 1), ISNULL(dc.definition, '')
+		FROM [sys].[columns] c
+			LEFT JOIN [sys].[identity_columns] ic ON ic.object_id = c.object_id AND ic.column_id = c.column_id
+			LEFT JOIN [sys].[default_constraints] dc ON dc.object_id = c.default_object_id
+		WHERE c.object_id = @id
+		ORDER BY c.column_id`
+	table.Columns = make([]model.TableColumn, 0)
+	err = connector.QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var column model.TableColumn
+			err := rows.Scan(&column.Name, &column.Type, &column.Nullable, &column.Identity,
+				&column.IdentitySeed, &column.IdentityIncrement, &column.Default)
+			if err != nil {
+				return err
+			}
+			table.Columns = append(table.Columns, column)
+		}
+		return rows.Err()
+	}, sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := connector.getTableKeys(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	table.Unique = make([]model.TableKey, 0)
+	for i, key := range keys {
+		if key.primary {
+			table.PrimaryKey = &keys[i].TableKey
+		} else {
+			table.Unique = append(table.Unique, key.TableKey)
+		}
+	}
+
+	table.Checks = make([]model.TableCheck, 0)
+	err = connector.QueryContext(ctx, `SELECT name, definition FROM [sys].[check_constraints]
+		WHERE parent_object_id = @id ORDER BY name`, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var check model.TableCheck
+			if err := rows.Scan(&check.Name, &check.Expression); err != nil {
+				return err
+			}
+			table.Checks = append(table.Checks, check)
+		}
+		return rows.Err()
+	}, sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+type tableKey struct {
+	model.TableKey
+	primary bool
+}
+
+// getTableKeys lists the PRIMARY KEY and UNIQUE constraints of the table, with their key columns in order
+func (c *Connector) getTableKeys(ctx context.Context, id int) ([]tableKey, error) {
+	stmtSQL := `SELECT kc.name, kc.type, i.type, c.name
+		FROM [sys].[key_constraints] kc
+			JOIN [sys].[indexes] i ON i.object_id = kc.parent_object_id AND i.index_id = kc.unique_index_id
+			JOIN [sys].[index_columns] ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id AND ic.key_ordinal > 0
+			JOIN [sys].[columns] c ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+		WHERE kc.parent_object_id = @id
+		ORDER BY kc.name, ic.key_ordinal`
+
+	keys := make([]tableKey, 0)
+	err := c.QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var name, keyType, column string
+			var indexType int
+			if err := rows.Scan(&name, &keyType, &indexType, &column); err != nil {
+				return err
+			}
+			if len(keys) == 0 || keys[len(keys)-1].Name != name {
+				keys = append(keys, tableKey{
+					TableKey: model.TableKey{Name: name, Columns: make([]string, 0), Clustered: indexType == 1},
+					primary:  keyType == "PK",
+				})
+			}
+			keys[len(keys)-1].Columns = append(keys[len(keys)-1].Columns, column)
+		}
+		return rows.Err()
+	}, sql.Named("id", id))
+	return keys, err
+}
+
+func (c *Connector) CreateTable(ctx context.Context, table *model.Table) error {
+	definitions := make([]string, 0)
+	for _, column := range table.Columns {
+		definitions = append(definitions, columnDefinition(column))
+	}
+	if table.PrimaryKey != nil {
+		definitions = append(definitions, keyDefinition("PRIMARY KEY", *table.PrimaryKey))
+	}
+	for _, key := range table.Unique {
+		definitions = append(definitions, keyDefinition("UNIQUE", key))
+	}
+	for _, check := range table.Checks {
+		definitions = append(definitions, checkDefinition(check))
+	}
+	stmtSQL := fmt.Sprintf("CREATE TABLE %s (\n\t%s\n)", tableName(table), strings.Join(definitions, ",\n\t"))
+	return c.setDatabase(table.Database).ExecContext(ctx, stmtSQL)
+}
+
+// dropDefaultSQL drops the default constraint of a column, if any, formatted with the literals of the table and of
+// the column. The server names the default constraints, so they are looked up by column.
+const dropDefaultSQL = `SET @default = (SELECT name FROM [sys].[default_constraints]
+		WHERE parent_object_id = OBJECT_ID(%[1]s) AND parent_column_id = COLUMNPROPERTY(OBJECT_ID(%[1]s), %[2]s, 'ColumnId'));
+	IF @default IS NOT NULL EXEC (N'ALTER TABLE ' + %[1]s + N' DROP CONSTRAINT ' + QUOTENAME(@default))`
+
+// AlterTable applies the differences between the old and the new columns and constraints of the table in place, in
+// one transaction. Changed constraints are dropped first and added back last, columns are dropped, renamed, altered,
+// then added. The columns missing from the new ones are renamed when a new column takes their position, see
+// model.RenamedColumns. Identities cannot be altered, the table has to be replaced.
+func (c *Connector) AlterTable(ctx context.Context, old, table *model.Table) error {
+	name := tableName(table)
+	statements := make([]string, 0)
+	alter := func(format string, args ...interface{}) {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ", name)+fmt.Sprintf(format, args...))
+	}
+	dropDefault := func(column string) {
+		statements = append(statements, fmt.Sprintf(dropDefaultSQL, "N"+quoteString(name), "N"+quoteString(column)))
+	}
+
+	oldChecks, checks := make(map[string]model.TableCheck), make(map[string]model.TableCheck)
+	for _, check := range old.Checks {
+		oldChecks[check.Name] = check
+	}
+	for _, check := range table.Checks {
+		checks[check.Name] = check
+	}
+	oldKeys, keys := make(map[string]model.TableKey), make(map[string]model.TableKey)
+	for _, key := range old.Unique {
+		oldKeys[key.Name] = key
+	}
+	for _, key := range table.Unique {
+		keys[key.Name] = key
+	}
+	primaryKeyChanged := !sameTableKey(old.PrimaryKey, table.PrimaryKey)
+
+	for _, check := range old.Checks {
+		if new, ok := checks[check.Name]; !ok || new.Expression != check.Expression {
+			alter("DROP CONSTRAINT %s", quoteIdentifier(check.Name))
+		}
+	}
+	for _, key := range old.Unique {
+		if new, ok := keys[key.Name]; !ok || !sameTableKey(&key, &new) {
+			alter("DROP CONSTRAINT %s", quoteIdentifier(key.Name))
+		}
+	}
+	if primaryKeyChanged && old.PrimaryKey != nil {
+		alter("DROP CONSTRAINT %s", quoteIdentifier(old.PrimaryKey.Name))
+	}
+
+	renamed := model.RenamedColumns(old.ColumnNames(), table.ColumnNames())
+	oldColumns := make(map[string]model.TableColumn)
+	for _, column := range old.Columns {
+		if newName, ok := renamed[column.Name]; ok {
+			oldColumns[newName] = column
+		} else {
+			oldColumns[column.Name] = column
+		}
+	}
+	columns := make(map[string]model.TableColumn)
+	for _, column := range table.Columns {
+		columns[column.Name] = column
+	}
+	for _, column := range old.Columns {
+		if _, ok := renamed[column.Name]; ok {
+			continue
+		}
+		if _, ok := columns[column.Name]; ok {
+			continue
+		}
+		dropDefault(column.Name)
+		alter("DROP COLUMN %s", quoteIdentifier(column.Name))
+	}
+	for _, column := range old.Columns {
+		if newName, ok := renamed[column.Name]; ok {
+			statements = append(statements, fmt.Sprintf("EXEC sp_rename N%s, N%s, 'COLUMN'",
+				quoteString(name+"."+quoteIdentifier(column.Name)), quoteString(newName)))
+		}
+	}
+	for _, column := range table.Columns {
+		oldColumn, ok := oldColumns[column.Name]
+		if !ok {
+			alter("ADD %s", columnDefinition(column))
+			continue
+		}
+		if oldColumn.Identity != column.Identity || column.Identity &&
+			(oldColumn.IdentitySeed != column.IdentitySeed || oldColumn.IdentityIncrement != column.IdentityIncrement) {
+			return fmt.Errorf("identity of column %s cannot be altered, the table %s has to be replaced", column.Name, name)
+		}
+		typeChanged := oldColumn.Type != column.Type || oldColumn.Nullable != column.Nullable
+		if !typeChanged && oldColumn.Default == column.Default {
+			continue
+		}
+		dropDefault(column.Name)
+		if typeChanged {
+			alter("ALTER COLUMN %s %s %s", quoteIdentifier(column.Name), column.Type, nullability(column))
+		}
+		if column.Default != "" {
+			alter("ADD %s FOR %s", defaultDefinition(column), quoteIdentifier(column.Name))
+		}
+	}
+
+	if primaryKeyChanged && table.PrimaryKey != nil {
+		alter("ADD %s", keyDefinition("PRIMARY KEY", *table.PrimaryKey))
+	}
+	for _, key := range table.Unique {
+		if old, ok := oldKeys[key.Name]; !ok || !sameTableKey(&old, &key) {
+			alter("ADD %s", keyDefinition("UNIQUE", key))
+		}
+	}
+	for _, check := range table.Checks {
+		if old, ok := oldChecks[check.Name]; !ok || old.Expression != check.Expression {
+			alter("ADD %s", checkDefinition(check))
+		}
+	}
+
+	if len(statements) == 0 {
+		return nil
+	}
+	// XACT_ABORT rolls the whole transaction back when any statement fails
+	stmtSQL := "SET XACT_ABORT ON;\nDECLARE @default sysname;\nBEGIN TRANSACTION;\n" +
+		strings.Join(statements, ";\n") + ";\nCOMMIT TRANSACTION"
+	return c.setDatabase(table.Database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) DeleteTable(ctx context.Context, database, schema, name string) error {
+	stmtSQL := fmt.Sprintf("DROP TABLE IF EXISTS %s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func tableName(table *model.Table) string {
+	return quoteIdentifier(table.Schema) + "." + quoteIdentifier(table.Name)
+}
+
+func columnDefinition(column model.TableColumn) string {
+	definition := quoteIdentifier(column.Name) + " " + column.Type
+	if column.Identity {
+		definition += fmt.Sprintf(" IDENTITY(%d, %d)", column.IdentitySeed, column.IdentityIncrement)
+	}
+	definition += " " + nullability(column)
+	if column.Default != "" {
+		definition += " " + defaultDefinition(column)
+	}
+	return definition
+}
+
+func nullability(column model.TableColumn) string {
+	if column.Nullable {
+		return "NULL"
+	}
+	return "NOT NULL"
+}
+
+// defaultDefinition leaves the name of the default constraint of the column to the server, names built from the
+// table and column names could exceed 128 characters or collide
+func defaultDefinition(column model.TableColumn) string {
+	return fmt.Sprintf("DEFAULT (%s)", column.Default)
+}
+
+func keyDefinition(kind string, key model.TableKey) string {
+	columns := make([]string, 0, len(key.Columns))
+	for _, column := range key.Columns {
+		columns = append(columns, quoteIdentifier(column))
+	}
+	clustered := "NONCLUSTERED"
+	if key.Clustered {
+		clustered = "CLUSTERED"
+	}
+	definition := fmt.Sprintf("%s %s (%s)", kind, clustered, strings.Join(columns, ", "))
+	if key.Name != "" {
+		definition = "CONSTRAINT " + quoteIdentifier(key.Name) + " " + definition
+	}
+	return definition
+}
+
+func checkDefinition(check model.TableCheck) string {
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", quoteIdentifier(check.Name), check.Expression)
+}
+
+// sameTableKey compares the keys, ignoring the name of new keys named by the server
+func sameTableKey(old, new *model.TableKey) bool {
+	if old == nil || new == nil {
+		return old == nil && new == nil
+	}
+	if new.Name != "" && new.Name != old.Name {
+		return false
+	}
+	return old.Clustered == new.Clustered && strings.Join(old.Columns, "\x00") == strings.Join(new.Columns, "\x00")
+}
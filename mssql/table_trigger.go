@@ -0,0 +1,139 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// tableTriggerHeader matches the CREATE TRIGGER header of a DML trigger definition, up to the AS preceding the body
+var tableTriggerHeader = regexp.MustCompile(`(?is)^\s*(?:CREATE|ALTER)\s+(?:OR\s+ALTER\s+)?TRIGGER\s+` + moduleName +
+	`\s+ON\s+` + moduleName + moduleOptions + `\s+(?:FOR|AFTER|INSTEAD\s+OF)\s+[\w\s,]+?(?:\s+NOT\s+FOR\s+REPLICATION)?\s+AS\b`)
+
+// GetTableTrigger looks the DML trigger up by schema and name, with its table, events, order and definition.
+// Returns nil when the trigger does not exist.
+func (c *Connector) GetTableTrigger(ctx context.Context, database, schema, name string) (*model.TableTrigger, error) {
+	stmtSQL := `SELECT t.object_id, OBJECT_SCHEMA_NAME(t.object_id), t.name, OBJECT_NAME(t.parent_id),
+			IIF(t.is_instead_of_trigger = 1, 'INSTEAD OF', 'AFTER'), t.is_disabled, ISNULL(m.definition, '')
+		FROM [sys].[triggers] t
+			LEFT JOIN [sys].[sql_modules] m ON m.object_id = t.object_id
+		WHERE t.parent_class = 1 AND OBJECT_SCHEMA_NAME(t.object_id) = @schema AND t.name = @name`
+
+	var id int
+	var disabled bool
+	trigger := &model.TableTrigger{Database: database}
+	connector := c.setDatabase(database)
+	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+		return row.Scan(&id, &trigger.Schema, &trigger.Name, &trigger.Table, &trigger.Timing, &disabled, &trigger.Definition)
+	}, sql.Named("schema", schema), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	trigger.Enabled = !disabled
+	if header := tableTriggerHeader.FindString(trigger.Definition); header != "" {
+		trigger.Body = strings.TrimSpace(trigger.Definition[len(header):])
+	}
+
+	var first, last int
+	trigger.Events = make([]string, 0)
+	err = connector.QueryContext(ctx, `SELECT type_desc, is_first, is_last FROM [sys].[trigger_events] WHERE object_id = @id`,
+		func(rows *sql.Rows) error {
+			for rows.Next() {
+				var event string
+				var isFirst, isLast bool
+				if err := rows.Scan(&event, &isFirst, &isLast); err != nil {
+					return err
+				}
+				trigger.Events = append(trigger.Events, event)
+				if isFirst {
+					first++
+				}
+				if isLast {
+					last++
+				}
+			}
+			return rows.Err()
+		}, sql.Named("id", id))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(trigger.Events)
+	switch {
+	case len(trigger.Events) > 0 && first == len(trigger.Events):
+		trigger.Order = "FIRST"
+	case len(trigger.Events) > 0 && last == len(trigger.Events):
+		trigger.Order = "LAST"
+	default:
+		trigger.Order = "NONE"
+	}
+	return trigger, nil
+}
+
+// SameTableTriggerDefinition tells whether the definition read back from the server is the one created by the trigger
+func SameTableTriggerDefinition(trigger *model.TableTrigger, definition string) bool {
+	return sameModuleDefinition(definition, tableTriggerStatement(trigger))
+}
+
+// CreateOrAlterTableTrigger creates or alters the trigger, then sets its state and order, which ALTER resets
+func (c *Connector) CreateOrAlterTableTrigger(ctx context.Context, trigger *model.TableTrigger) error {
+	connector := c.setDatabase(trigger.Database)
+	if err := connector.ExecContext(ctx, tableTriggerStatement(trigger)); err != nil {
+		return err
+	}
+	if err := connector.SetTableTriggerEnabled(ctx, trigger, trigger.Enabled); err != nil {
+		return err
+	}
+	return connector.SetTableTriggerOrder(ctx, trigger)
+}
+
+func (c *Connector) SetTableTriggerEnabled(ctx context.Context, trigger *model.TableTrigger, enabled bool) error {
+	state := "DISABLE"
+	if enabled {
+		state = "ENABLE"
+	}
+	stmtSQL := fmt.Sprintf("%s TRIGGER %s.%s ON %s.%s", state, quoteIdentifier(trigger.Schema), quoteIdentifier(trigger.Name),
+		quoteIdentifier(trigger.Schema), quoteIdentifier(trigger.Table))
+	return c.setDatabase(trigger.Database).ExecContext(ctx, stmtSQL)
+}
+
+// triggerOrders are the @order arguments of sp_settriggerorder
+var triggerOrders = map[string]string{"FIRST": "First", "LAST": "Last", "NONE": "None"}
+
+// SetTableTriggerOrder makes the AFTER trigger fire first or last among the triggers of each of its events.
+// INSTEAD OF triggers have no order.
+func (c *Connector) SetTableTriggerOrder(ctx context.Context, trigger *model.TableTrigger) error {
+	if trigger.Timing != "AFTER" && trigger.Order == "NONE" {
+		return nil
+	}
+	connector := c.setDatabase(trigger.Database)
+	name := quoteIdentifier(trigger.Schema) + "." + quoteIdentifier(trigger.Name)
+	for _, event := range trigger.Events {
+		err := connector.ExecContext(ctx, "EXEC sp_settriggerorder @triggername = @name, @order = @order, @stmttype = @event",
+			sql.Named("name", name), sql.Named("order", triggerOrders[trigger.Order]), sql.Named("event", event))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Connector) DeleteTableTrigger(ctx context.Context, database, schema, name string) error {
+	stmtSQL := fmt.Sprintf("DROP TRIGGER IF EXISTS %s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func tableTriggerStatement(trigger *model.TableTrigger) string {
+	events := append([]string{}, trigger.Events...)
+	sort.Strings(events)
+	return fmt.Sprintf("CREATE OR ALTER TRIGGER %s.%s ON %s.%s %s %s AS\n%s", quoteIdentifier(trigger.Schema),
+		quoteIdentifier(trigger.Name), quoteIdentifier(trigger.Schema), quoteIdentifier(trigger.Table), trigger.Timing,
+		strings.Join(events, ", "), strings.TrimSpace(trigger.Body))
+}
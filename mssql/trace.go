@@ -0,0 +1,80 @@
+package mssql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const redacted = "'***'"
+
+// secretLiteral matches the values of PASSWORD = '...', SECRET = '...', @rmtpassword = N'...' and the like,
+// including hashed passwords (0x...) and parameters (@p1), so they are not written to the logs
+var secretLiteral = regexp.MustCompile(`(?i)((?:@\w*)?\b\w*(?:password|secret|token)\w*\s*=\s*)(N?'(?:[^']|'')*'|0x[0-9a-f]+|@\w+)`)
+
+// secretKey matches the values of the password keys of connection strings, e.g. in the provider string of linked
+// servers, quoted with braces or ending at the next semicolon
+var secretKey = regexp.MustCompile(`(?i)(\b(?:password|pwd)\s*=\s*)(\{(?:[^}]|\}\})*\}|[^\s;'@{](?:[^;']|'')*)`)
+
+// secretName matches the names of named arguments holding secrets
+var secretName = regexp.MustCompile(`(?i)password|secret|token`)
+
+// Redact masks the passwords and secrets of the statement
+func Redact(statement string) string {
+	statement = secretLiteral.ReplaceAllStringFunc(statement, func(match string) string {
+		parts := secretLiteral.FindStringSubmatch(match)
+		if strings.HasPrefix(parts[2], "@") {
+			// parameters are redacted among the arguments
+			return match
+		}
+		return parts[1] + redacted
+	})
+	return secretKey.ReplaceAllString(statement, "${1}***")
+}
+
+// traceStatement logs the statement, its arguments and its outcome: statements at DEBUG level,
+// arguments and durations at TRACE level, i.e. TF_LOG=DEBUG or TF_LOG=TRACE
+func (c *Connector) traceStatement(what, statement string, args []interface{}) func(error) {
+	start := time.Now()
+	log.Printf("[DEBUG] mssql: %s server=%q database=%q: %s", what, c.Host, c.Database, Redact(statement))
+	if len(args) > 0 {
+		log.Printf("[TRACE] mssql: %s arguments: %s", what, redactArgs(statement, args))
+	}
+	return func(err error) {
+		if err != nil {
+			log.Printf("[DEBUG] mssql: %s failed after %s: %s", what, time.Since(start).Round(time.Millisecond), err)
+			return
+		}
+		log.Printf("[TRACE] mssql: %s done in %s", what, time.Since(start).Round(time.Millisecond))
+	}
+}
+
+// redactArgs formats the arguments, masking named secret arguments and the positional ones
+// the statement assigns to a password or secret
+func redactArgs(statement string, args []interface{}) string {
+	secretParams := map[string]bool{}
+	for _, parts := range secretLiteral.FindAllStringSubmatch(statement, -1) {
+		if strings.HasPrefix(parts[2], "@") {
+			secretParams[strings.ToLower(parts[2][1:])] = true
+		}
+	}
+
+	values := make([]string, 0, len(args))
+	for i, arg := range args {
+		name := "p" + strconv.Itoa(i+1)
+		value := arg
+		if named, ok := arg.(sql.NamedArg); ok {
+			name, value = named.Name, named.Value
+		}
+		if secretParams[strings.ToLower(name)] || secretName.MatchString(name) {
+			values = append(values, fmt.Sprintf("@%s=%s", name, redacted))
+			continue
+		}
+		values = append(values, fmt.Sprintf("@%s=%#v", name, value))
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,35 @@
+package mssql
+
+import "testing"
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		statement, redacted string
+	}{
+		{"CREATE LOGIN [app] WITH PASSWORD = 'Pa55word!'", "CREATE LOGIN [app] WITH PASSWORD = '***'"},
+		{"ALTER LOGIN [app] WITH PASSWORD = N'Pa55word!' MUST_CHANGE, CHECK_EXPIRATION = ON",
+			"ALTER LOGIN [app] WITH PASSWORD = '***' MUST_CHANGE, CHECK_EXPIRATION = ON"},
+		{"CREATE LOGIN [app] WITH PASSWORD = 0x0200A1B2C3 HASHED", "CREATE LOGIN [app] WITH PASSWORD = '***' HASHED"},
+		{"CREATE CREDENTIAL [blob] WITH IDENTITY = 'SHARED ACCESS SIGNATURE', SECRET = 'sv=2020&sig=abc'",
+			"CREATE CREDENTIAL [blob] WITH IDENTITY = 'SHARED ACCESS SIGNATURE', SECRET = '***'"},
+		{"CREATE MASTER KEY ENCRYPTION BY PASSWORD='it''s; DROP TABLE [t]'; SELECT 1",
+			"CREATE MASTER KEY ENCRYPTION BY PASSWORD='***'; SELECT 1"},
+		{"EXEC sp_addlinkedsrvlogin @rmtsrvname = N'remote', @rmtuser = N'sa', @rmtpassword = N'Pa''55'",
+			"EXEC sp_addlinkedsrvlogin @rmtsrvname = N'remote', @rmtuser = N'sa', @rmtpassword = '***'"},
+		{"EXEC sp_addlinkedserver @server = N'remote', @provstr = N'Server=db;User ID=sa;Password=Pa55 word;Encrypt=yes'",
+			"EXEC sp_addlinkedserver @server = N'remote', @provstr = N'Server=db;User ID=sa;Password=***;Encrypt=yes'"},
+		{"EXEC sp_addlinkedserver @server = N'remote', @provstr = N'Server=db;UID=sa;PWD={Pa;55}}''};Encrypt=yes'",
+			"EXEC sp_addlinkedserver @server = N'remote', @provstr = N'Server=db;UID=sa;PWD=***;Encrypt=yes'"},
+		{"SELECT * FROM OPENROWSET('MSOLEDBSQL', 'Server=db;Uid=sa;Pwd=it''s', 'SELECT 1')",
+			"SELECT * FROM OPENROWSET('MSOLEDBSQL', 'Server=db;Uid=sa;Pwd=***', 'SELECT 1')"},
+		// parameters are redacted among the arguments
+		{"ALTER LOGIN [app] WITH PASSWORD = @p1", "ALTER LOGIN [app] WITH PASSWORD = @p1"},
+		{"SELECT name, is_policy_checked FROM sys.sql_logins WHERE name = @name",
+			"SELECT name, is_policy_checked FROM sys.sql_logins WHERE name = @name"},
+	}
+	for _, c := range cases {
+		if redacted := Redact(c.statement); redacted != c.redacted {
+			t.Errorf("Redact(%q) = %q, expected %q", c.statement, redacted, c.redacted)
+		}
+	}
+}
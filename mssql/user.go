@@ -4,7 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"strconv"
 	"strings"
 
 	"github.com/rbernardini/terraform-provider-mssql/model"
@@ -17,41 +17,46 @@ func (c *Connector) CreateUser(ctx context.Context, user *model.User) error {
 		return err
 	}
 
-	stmtSQL := fmt.Sprintf("CREATE USER %s ", user.Username)
+	stmtSQL := "CREATE USER " + quoteIdentifier(user.Username)
 	if user.AuthType == "DATABASE" && user.LoginName == "" && user.Password == "" {
 		return fmt.Errorf("for 'DATABASE' authentication type user password is required")
 	}
 
+	with := make([]string, 0)
 	if user.LoginName != "" {
-		stmtSQL += fmt.Sprintf("FOR LOGIN [%s]", user.LoginName)
+		stmtSQL += " FOR LOGIN " + quoteIdentifier(user.LoginName)
 	}
 	if user.Password != "" {
-		stmtSQL += fmt.Sprintf("WITH PASSWORD = '%s'", user.Password)
+		with = append(with, "PASSWORD = "+quoteString(user.Password))
 	}
-	if user.AuthType == "EXTERNAL" {
+	if user.AuthType == "EXTERNAL" && user.LoginName == "" {
+		// Users FOR LOGIN of an external login already map to the Azure AD principal
 		if strings.Contains(version, "Microsoft SQL Azure") {
 			if user.ObjectId != "" {
-				stmtSQL += " WITH SID=CONVERT(varchar(64), CAST(CAST(" + user.ObjectId +
-					" AS UNIQUEIDENTIFIER) AS VARBINARY(16)), 1), TYPE=E"
+				sid, err := externalSidOption(user.ObjectId, user.IsGroup)
+				if err != nil {
+					return err
+				}
+				with = append(with, sid)
 			} else {
 				stmtSQL += " FROM EXTERNAL PROVIDER"
 			}
 		}
 	}
-
-	if len(user.Options) > 0 {
-		if !strings.Contains(stmtSQL, " WITH ") {
-			stmtSQL += " WITH "
-		}
-		for opt := range user.Options {
-			value := user.Options[opt].ValueOrSqlNull()
-			stmtSQL += fmt.Sprintf(" %s = %s,", opt, value)
+	if user.DefaultSchema != "" {
+		with = append(with, "DEFAULT_SCHEMA = "+quoteIdentifier(user.DefaultSchema))
+	}
+	for opt := range user.Options {
+		if user.DefaultSchema != "" && strings.EqualFold(opt, "default_schema") {
+			continue
 		}
-		stmtSQL = strings.TrimRight(stmtSQL, ", ")
+		value := user.Options[opt].ValueOrSqlNull()
+		with = append(with, fmt.Sprintf("%s = %s", opt, value))
+	}
+	if len(with) > 0 {
+		stmtSQL += " WITH " + strings.Join(with, ", ")
 	}
 
-	log.Printf("Using database: '%s'", user.Database)
-	log.Printf("Executing statement: %s", stmtSQL)
 	err = c.
 		setDatabase(user.Database).
 		ExecContext(ctx, stmtSQL)
@@ -59,13 +64,11 @@ func (c *Connector) CreateUser(ctx context.Context, user *model.User) error {
 }
 
 func (c *Connector) DeleteUser(ctx context.Context, user *model.User) error {
-	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [%s].[sys].[database_principals] WHERE [name] = '%s') "+
-		"DROP USER %s", user.Database, user.Username, user.Username)
-
-	log.Printf("Executing statement: %s", stmtSQL)
+	stmtSQL := "IF EXISTS (SELECT 1 FROM [sys].[database_principals] WHERE [name] = @name) DROP USER " +
+		quoteIdentifier(user.Username)
 
 	return c.setDatabase(user.Database).
-		ExecContext(ctx, stmtSQL)
+		ExecContext(ctx, stmtSQL, sql.Named("name", user.Username))
 }
 
 func (c *Connector) GetUserRoles(ctx context.Context, username string) ([]string, error) {
@@ -91,21 +94,34 @@ func (c *Connector) GetUserRoles(ctx context.Context, username string) ([]string
 }
 
 func (c *Connector) GetUser(ctx context.Context, database string, username string) (*model.User, error) {
+	return c.getUser(ctx, database, "p.name = @name", sql.Named("name", username))
+}
+
+// GetUserByPrincipalID looks the user up by its stable identity. Returns nil when the user does not exist.
+func (c *Connector) GetUserByPrincipalID(ctx context.Context, database string, principalID int) (*model.User, error) {
+	return c.getUser(ctx, database, "p.principal_id = @principal_id", sql.Named("principal_id", principalID))
+}
+
+// getUser looks the user up with the filter on sys.database_principals p, which takes its values as parameters
+func (c *Connector) getUser(ctx context.Context, database string, filter string, args ...interface{}) (*model.User, error) {
 	stmtSQL := fmt.Sprintf(`SELECT 
 		p.principal_id, p.name, p.authentication_type_desc, p.default_schema_name, p.default_language_name, p.sid
 		FROM [%s].[sys].[database_principals] p 
-		WHERE p.type = 'S' AND p.name LIKE '%s'`, database, username)
-	log.Printf("Executing statement: %s", stmtSQL)
+		WHERE p.type IN ('S', 'E', 'X') AND %s`, database, filter)
 	var defaultSchema, defaultLanguage model.NullString
 	var sid []byte
 	user := &model.User{}
 	err := c.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
 		return row.Scan(&user.PrincipalID, &user.Username, &user.AuthType, &defaultSchema, &defaultLanguage, &sid)
-	})
+	}, args...)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 	user.Database = database
+	user.DefaultSchema = string(defaultSchema)
 	user.Options = make(model.OptionsList)
 	if defaultSchema != "" {
 		user.Options["default_schema"] = defaultSchema
@@ -117,6 +133,17 @@ func (c *Connector) GetUser(ctx context.Context, database string, username strin
 	return user, err
 }
 
+// SetUserPassword changes the password of a contained database user
+func (c *Connector) SetUserPassword(ctx context.Context, database string, username string, password string) error {
+	stmtSQL := fmt.Sprintf("ALTER USER %s WITH PASSWORD = %s", quoteIdentifier(username), quoteString(password))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func (c *Connector) RenameUser(ctx context.Context, database string, oldName string, newName string) error {
+	stmtSQL := fmt.Sprintf("ALTER USER %s WITH NAME = %s", quoteIdentifier(oldName), quoteIdentifier(newName))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
 func ParseUserId(id string) (database string, username string, err error) {
 	lastSeparatorIndex := strings.LastIndex(id, "/")
 
@@ -128,3 +155,16 @@ func ParseUserId(id string) (database string, username string, err error) {
 	username = id[lastSeparatorIndex+1:]
 	return
 }
+
+// ParsePrincipalId splits database/principal_id ID of database principals. Legacy and imported IDs
+// may hold the principal name instead, it is returned with zero principalID.
+func ParsePrincipalId(id string) (database string, principalID int, name string, err error) {
+	database, name, err = ParseUserId(id)
+	if err != nil {
+		return
+	}
+	if id, convErr := strconv.Atoi(name); convErr == nil {
+		return database, id, "", nil
+	}
+	return
+}
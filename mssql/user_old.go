@@ -172,7 +172,7 @@ func (c *Connector) CreateUserOld(ctx context.Context, database string, user *mo
 			sql.Named("loginName", user.LoginName),
 			sql.Named("password", user.Password),
 			sql.Named("authType", user.AuthType),
-			sql.Named("defaultSchema", "dbo"),
+			sql.Named("defaultSchema", firstNonEmpty(user.DefaultSchema, "dbo")),
 			sql.Named("defaultLanguage", "NONE"),
 			sql.Named("roles", strings.Join(user.Roles, ",")),
 		)
@@ -246,7 +246,7 @@ func (c *Connector) UpdateUser(ctx context.Context, database string, user *model
 		ExecContext(ctx, cmd,
 			sql.Named("database", user.Database),
 			sql.Named("username", user.Username),
-			sql.Named("defaultSchema", "dbo"),
+			sql.Named("defaultSchema", firstNonEmpty(user.DefaultSchema, "dbo")),
 			sql.Named("defaultLanguage", "NONE"),
 			sql.Named("roles", strings.Join(user.Roles, ",")),
 		)
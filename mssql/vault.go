@@ -0,0 +1,88 @@
+package mssql
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// VaultSecret is a field of a HashiCorp Vault KV secret, version 1 or 2
+type VaultSecret struct {
+	// Address and Token default to VAULT_ADDR and VAULT_TOKEN, then ~/.vault-token
+	Address   string
+	Token     string
+	Namespace string
+	// Path is the API path of the secret, e.g. secret/data/sql/terraform for KV version 2
+	Path string
+	// Field of the secret holding the password, "password" if empty
+	Field string
+}
+
+func (v *VaultSecret) ReadSecret() (string, error) {
+	address := firstNonEmpty(v.Address, os.Getenv("VAULT_ADDR"))
+	if address == "" {
+		return "", errors.New("reading Vault secret: address is not set, nor VAULT_ADDR")
+	}
+	token, err := v.token()
+	if err != nil {
+		return "", err
+	}
+
+	url := strings.TrimSuffix(address, "/") + "/v1/" + strings.TrimPrefix(v.Path, "/")
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if namespace := firstNonEmpty(v.Namespace, os.Getenv("VAULT_NAMESPACE")); namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "reading Vault secret %s", v.Path)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []string               `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", errors.Wrapf(err, "reading Vault secret %s: %s", v.Path, resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("reading Vault secret %s: %s: %s", v.Path, resp.Status, strings.Join(body.Errors, ", "))
+	}
+
+	data := body.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok && data["metadata"] != nil {
+		// KV version 2 wraps the fields with the metadata of the version
+		data = nested
+	}
+	field := firstNonEmpty(v.Field, "password")
+	value, ok := data[field].(string)
+	if !ok {
+		return "", errors.Errorf("reading Vault secret %s: no string field %q", v.Path, field)
+	}
+	return value, nil
+}
+
+func (v *VaultSecret) token() (string, error) {
+	if token := firstNonEmpty(v.Token, os.Getenv("VAULT_TOKEN")); token != "" {
+		return token, nil
+	}
+	// written by vault login
+	home, err := os.UserHomeDir()
+	if err == nil {
+		if token, err := ioutil.ReadFile(filepath.Join(home, ".vault-token")); err == nil {
+			return strings.TrimSpace(string(token)), nil
+		}
+	}
+	return "", errors.New("reading Vault secret: token is not set, nor VAULT_TOKEN or ~/.vault-token")
+}
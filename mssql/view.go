@@ -0,0 +1,60 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// viewHeader matches the CREATE VIEW header of a view definition, up to the AS preceding the body
+var viewHeader = regexp.MustCompile(`(?is)^\s*(CREATE|ALTER)\s+(OR\s+ALTER\s+)?VIEW\s+.+?(\s+WITH\s+[\w\s,]+?)?\s+AS\b`)
+
+// GetView looks the view up by schema and name, with its definition. Returns nil when the view does not exist.
+func (c *Connector) GetView(ctx context.Context, database, schema, name string) (*model.View, error) {
+	stmtSQL := `SELECT SCHEMA_NAME(v.schema_id), v.name, ISNULL(m.definition, ''), ISNULL(m.is_schema_bound, 0)
+		FROM [sys].[views] v
+			LEFT JOIN [sys].[sql_modules] m ON m.object_id = v.object_id
+		WHERE v.schema_id = SCHEMA_ID(@schema) AND v.name = @name`
+
+	view := &model.View{Database: database}
+	err := c.setDatabase(database).
+		QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
+			return row.Scan(&view.Schema, &view.Name, &view.Definition, &view.SchemaBinding)
+		}, sql.Named("schema", schema), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if header := viewHeader.FindString(view.Definition); header != "" {
+		view.Body = strings.TrimSpace(view.Definition[len(header):])
+	}
+	return view, nil
+}
+
+func (c *Connector) CreateView(ctx context.Context, view *model.View) error {
+	return c.setDatabase(view.Database).ExecContext(ctx, viewStatement("CREATE", view))
+}
+
+func (c *Connector) AlterView(ctx context.Context, view *model.View) error {
+	return c.setDatabase(view.Database).ExecContext(ctx, viewStatement("ALTER", view))
+}
+
+func (c *Connector) DeleteView(ctx context.Context, database, schema, name string) error {
+	stmtSQL := fmt.Sprintf("DROP VIEW IF EXISTS %s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+	return c.setDatabase(database).ExecContext(ctx, stmtSQL)
+}
+
+func viewStatement(verb string, view *model.View) string {
+	options := ""
+	if view.SchemaBinding {
+		options = " WITH SCHEMABINDING"
+	}
+	return fmt.Sprintf("%s VIEW %s.%s%s AS\n%s", verb, quoteIdentifier(view.Schema), quoteIdentifier(view.Name), options,
+		strings.TrimSpace(view.Body))
+}
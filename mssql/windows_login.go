@@ -0,0 +1,78 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+// GetWindowsLogin looks the Windows login or group up by SID, or by name when SID is empty.
+// Returns nil when the login does not exist.
+func (c *Connector) GetWindowsLogin(ctx context.Context, sid string, name string) (*model.WindowsLogin, error) {
+	stmtSQL := `SELECT name, type_desc, CONVERT(varchar(172), sid, 1), default_database_name, default_language_name, is_disabled
+		FROM [master].[sys].[server_principals]
+		WHERE type IN ('U', 'G') AND `
+	if sid != "" {
+		stmtSQL += "sid = CONVERT(varbinary(85), @sid, 1)"
+	} else {
+		stmtSQL += "[name] = @name"
+	}
+
+	var defaultDatabase, defaultLanguage model.NullString
+	login := &model.WindowsLogin{}
+	err := c.QueryRowContext(ctx, stmtSQL, func(r *sql.Row) error {
+		return r.Scan(&login.Name, &login.Type, &login.Sid, &defaultDatabase, &defaultLanguage, &login.Disabled)
+	}, sql.Named("sid", sid), sql.Named("name", name))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	login.DefaultDatabase, login.DefaultLanguage = string(defaultDatabase), string(defaultLanguage)
+	return login, nil
+}
+
+// CreateWindowsLogin creates the login FROM WINDOWS, the server resolves DOMAIN\name with Active Directory
+func (c *Connector) CreateWindowsLogin(ctx context.Context, login *model.WindowsLogin) error {
+	stmtSQL := fmt.Sprintf("CREATE LOGIN %s FROM WINDOWS", quoteIdentifier(login.Name))
+	options := loginDefaultOptions(login.DefaultDatabase, login.DefaultLanguage)
+	if len(options) > 0 {
+		stmtSQL += " WITH " + strings.Join(options, ", ")
+	}
+	if err := c.ExecContext(ctx, stmtSQL); err != nil {
+		return err
+	}
+	if login.Disabled {
+		return c.SetLoginDisabled(ctx, login.Name, true)
+	}
+	return nil
+}
+
+// AlterLoginDefaults sets the default database and language of the login, left as is when empty
+func (c *Connector) AlterLoginDefaults(ctx context.Context, name string, defaultDatabase string, defaultLanguage string) error {
+	return c.AlterLogin(ctx, name, loginDefaultOptions(defaultDatabase, defaultLanguage))
+}
+
+func loginDefaultOptions(defaultDatabase string, defaultLanguage string) []string {
+	options := make([]string, 0)
+	if defaultDatabase != "" {
+		options = append(options, "DEFAULT_DATABASE = "+quoteIdentifier(defaultDatabase))
+	}
+	if defaultLanguage != "" {
+		options = append(options, "DEFAULT_LANGUAGE = "+quoteIdentifier(defaultLanguage))
+	}
+	return options
+}
+
+// SetLoginDisabled disables or enables the login, disabled logins cannot connect
+func (c *Connector) SetLoginDisabled(ctx context.Context, name string, disabled bool) error {
+	action := "ENABLE"
+	if disabled {
+		action = "DISABLE"
+	}
+	return c.ExecContext(ctx, fmt.Sprintf("ALTER LOGIN %s %s", quoteIdentifier(name), action))
+}
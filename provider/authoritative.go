@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// authoritativeSchema is the switch making the resource the only grant of its permission or role
+func authoritativeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Revoke the grants of the same permission in the same state, or of the same role, to principals not declared by this resource",
+	}
+}
+
+// declaredSchema lists the other principals an authoritative resource grants its permission or role to
+func declaredSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: description,
+	}
+}
+
+// unmanagedSchema lists the principals an authoritative resource found and revokes on the next apply
+func unmanagedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Other principals holding the permission or role, revoked on apply when authoritative",
+	}
+}
+
+// authoritativeDiff plans the revocation of the unmanaged grants found by Read. The principals declared besides
+// the one of the resource require authoritative, only one resource per permission or role being able to own them.
+func authoritativeDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		authoritative := d.Get("authoritative").(bool)
+		if !authoritative && d.Get(key).(*schema.Set).Len() > 0 {
+			return fmt.Errorf("%s requires authoritative", key)
+		}
+		if !authoritative || len(d.Get("unmanaged").([]interface{})) == 0 {
+			return nil
+		}
+		return d.SetNew("unmanaged", []string{})
+	}
+}
+
+// unmanagedGrants grants a permission or role to the principal of the resource and the declared ones,
+// and lists and revokes its grants to other principals. System principals the server refuses to change,
+// like dbo, sa, fixed roles and ##MS_...## certificate principals, are left out by list.
+type unmanagedGrants struct {
+	principal string
+	// declared is the attribute of the other principals declared by the resource
+	declared string
+	list     func(ctx context.Context) ([]string, error)
+	grant    func(ctx context.Context, principal string) error
+	revoke   func(ctx context.Context, principal string) error
+}
+
+func (g unmanagedGrants) managed(d *schema.ResourceData) []string {
+	managed := []string{g.principal}
+	for _, principal := range d.Get(g.declared).(*schema.Set).List() {
+		managed = append(managed, principal.(string))
+	}
+	return managed
+}
+
+// read sets the declared principals still holding the grant, and the unmanaged ones of authoritative resources
+func (g unmanagedGrants) read(ctx context.Context, d *schema.ResourceData) error {
+	unmanaged := make([]string, 0)
+	declared := make([]string, 0)
+	if d.Get("authoritative").(bool) {
+		principals, err := g.list(ctx)
+		if err != nil {
+			return err
+		}
+		managed := g.managed(d)
+		for _, principal := range principals {
+			if !containsFold(managed, principal) {
+				unmanaged = append(unmanaged, principal)
+			}
+		}
+		for _, principal := range managed[1:] {
+			if containsFold(principals, principal) {
+				declared = append(declared, principal)
+			}
+		}
+	}
+	if err := d.Set(g.declared, declared); err != nil {
+		return err
+	}
+	return d.Set("unmanaged", unmanaged)
+}
+
+// apply grants the declared principals missing the grant and revokes the unmanaged ones of authoritative resources
+func (g unmanagedGrants) apply(ctx context.Context, d *schema.ResourceData) error {
+	if !d.Get("authoritative").(bool) {
+		return nil
+	}
+	principals, err := g.list(ctx)
+	if err != nil {
+		return err
+	}
+	managed := g.managed(d)
+	for _, principal := range managed[1:] {
+		if !containsFold(principals, principal) {
+			if err := g.grant(ctx, principal); err != nil {
+				return err
+			}
+		}
+	}
+	for _, principal := range principals {
+		if !containsFold(managed, principal) {
+			if err := g.revoke(ctx, principal); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// revokeDeclared revokes the grants to the declared principals, when the resource is destroyed
+func (g unmanagedGrants) revokeDeclared(ctx context.Context, d *schema.ResourceData) error {
+	managed := g.managed(d)
+	if len(managed) == 1 {
+		return nil
+	}
+	principals, err := g.list(ctx)
+	if err != nil {
+		return err
+	}
+	for _, principal := range managed[1:] {
+		if containsFold(principals, principal) {
+			if err := g.revoke(ctx, principal); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func DataSourceDatabaseRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ShowDatabaseRoles,
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"exclude_fixed_roles": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not list fixed database roles (db_owner, db_datareader, ...)",
+			},
+			"names": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names, suitable for for_each",
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_fixed_role": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"member_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ShowDatabaseRoles(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := meta.(*mssql.Connector)
+
+	database := d.Get("database").(string)
+	roles, err := connector.GetDatabaseRoles(ctx, database, d.Get("exclude_fixed_roles").(bool))
+	if err != nil {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("read roles of database %s", database),
+			Detail:   err.Error(),
+		}}
+	}
+
+	names := make([]interface{}, 0, len(roles))
+	items := make([]interface{}, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+		items = append(items, role.ToMap())
+	}
+
+	if err = d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("roles", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(database)
+	return nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDatabaseRoles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseRolesConfig_basic("master", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mssql_database_roles.test", "database", "master"),
+					resource.TestCheckTypeSetElemAttr("data.mssql_database_roles.test", "names.*", "db_owner"),
+					resource.TestCheckTypeSetElemAttr("data.mssql_database_roles.test", "names.*", "public"),
+				),
+			},
+			{
+				Config: testAccDatabaseRolesConfig_basic("master", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccTablesCount("data.mssql_database_roles.test", "roles.#", func(rn string, count int) error {
+						if count < 1 {
+							return fmt.Errorf("%s: public role not found", rn)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("data.mssql_database_roles.test", "roles.0.is_fixed_role", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseRolesConfig_basic(database string, excludeFixed bool) string {
+	return fmt.Sprintf(`
+data "mssql_database_roles" "test" {
+		database            = "%s"
+		exclude_fixed_roles = %t
+}`, database, excludeFixed)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func DataSourceSchemas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ShowSchemas,
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "LIKE pattern schema names must match",
+			},
+			"include_builtin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List built-in schemas (dbo, guest, sys, INFORMATION_SCHEMA, db_*) too",
+			},
+			"names": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Schema names, suitable for for_each",
+			},
+			"schemas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"owner": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Owner principal name, empty if the owner does not exist anymore",
+						},
+						"owner_principal_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_builtin": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ShowSchemas(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := meta.(*mssql.Connector)
+
+	database := d.Get("database").(string)
+	schemas, err := connector.GetDatabaseSchemas(ctx, database, d.Get("name_pattern").(string), d.Get("include_builtin").(bool))
+	if err != nil {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("read schemas of database %s", database),
+			Detail:   err.Error(),
+		}}
+	}
+
+	names := make([]interface{}, 0, len(schemas))
+	items := make([]interface{}, 0, len(schemas))
+	for _, s := range schemas {
+		names = append(names, s.Name)
+		items = append(items, s.ToMap())
+	}
+
+	if err = d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("schemas", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(database)
+	return nil
+}
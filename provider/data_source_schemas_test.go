@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSchemas(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemasConfig_basic("master", "%", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.mssql_schemas.test", "names.*", "dbo"),
+					resource.TestCheckTypeSetElemAttr("data.mssql_schemas.test", "names.*", "sys"),
+				),
+			},
+			{
+				Config: testAccSchemasConfig_basic("master", "db[_]%", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mssql_schemas.test", "schemas.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchemasConfig_basic(database string, pattern string, includeBuiltin bool) string {
+	return fmt.Sprintf(`
+data "mssql_schemas" "test" {
+		database        = "%s"
+		name_pattern    = "%s"
+		include_builtin = %t
+}`, database, pattern, includeBuiltin)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func DataSourceServerPrincipalPermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ShowServerPrincipalPermissions,
+		Schema: map[string]*schema.Schema{
+			"login_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"principal_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "'server', or 'master_database' on Azure SQL Database where roles and permissions of the user in master are reported",
+			},
+			"is_server_admin": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the login is the server admin of Azure SQL logical server",
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"permission": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ShowServerPrincipalPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := meta.(*mssql.Connector)
+
+	principal, err := connector.GetServerPrincipalPermissions(ctx, d.Get("login_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	permissions := make([]interface{}, 0, len(principal.Permissions))
+	for _, permission := range principal.Permissions {
+		permissions = append(permissions, permission.ToMap())
+	}
+
+	diags := diag.Diagnostics{}
+	for key, value := range map[string]interface{}{
+		"principal_id":    principal.PrincipalID,
+		"type":            principal.Type,
+		"scope":           principal.Scope,
+		"is_server_admin": principal.IsServerAdmin,
+		"roles":           principal.Roles,
+		"permissions":     permissions,
+	} {
+		if err = d.Set(key, value); err != nil {
+			diags = append(diags, diag.FromErr(err)[0])
+		}
+	}
+
+	if !diags.HasError() {
+		d.SetId(principal.Name)
+	}
+	return diags
+}
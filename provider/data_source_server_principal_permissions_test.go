@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceServerPrincipalPermissions(t *testing.T) {
+	login := os.Getenv("MSSQL_USERNAME")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerPrincipalPermissionsConfig_basic(login),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mssql_server_principal_permissions.test", "login_name", login),
+					resource.TestCheckResourceAttrSet("data.mssql_server_principal_permissions.test", "principal_id"),
+					resource.TestCheckResourceAttrSet("data.mssql_server_principal_permissions.test", "scope"),
+				),
+			},
+			{
+				Config:      testAccServerPrincipalPermissionsConfig_basic("__login_does_not_exist__"),
+				ExpectError: regexp.MustCompile("login '__login_does_not_exist__' not found"),
+			},
+		},
+	})
+}
+
+func testAccServerPrincipalPermissionsConfig_basic(login string) string {
+	return fmt.Sprintf(`
+data "mssql_server_principal_permissions" "test" {
+		login_name = "%s"
+}`, login)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	passwordLower   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordDigits  = "0123456789"
+	passwordSpecial = "!#$%&*()-_=+[]{}<>:?"
+)
+
+// generatePasswordSchema is the block generating the password of a login or user instead of configuring one
+func generatePasswordSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Generate a random password, exported as generated_password",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"length": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      32,
+					ValidateFunc: validation.IntBetween(12, 128),
+				},
+				"special": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Include special characters",
+				},
+				"override_special": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Special characters to use instead of the default ones",
+				},
+				"keepers": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Arbitrary values, changing them generates a new password",
+				},
+			},
+		},
+	}
+}
+
+// generatePassword returns a random password of the generate_password block, or an empty one without the block.
+// It has a character of each class, so that it meets the Windows password policy enforced by CHECK_POLICY.
+func generatePassword(data *schema.ResourceData) (string, error) {
+	blocks := data.Get("generate_password").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return "", nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	classes := []string{passwordLower, passwordUpper, passwordDigits}
+	if block["special"].(bool) {
+		special := passwordSpecial
+		if override := block["override_special"].(string); override != "" {
+			special = override
+		}
+		classes = append(classes, special)
+	}
+
+	length := block["length"].(int)
+	password := make([]byte, 0, length)
+	for _, class := range classes {
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+	all := strings.Join(classes, "")
+	for len(password) < length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// shuffle, so that the classes are not always in the same positions
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func randomChar(chars string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, fmt.Errorf("generating password: %v", err)
+	}
+	return chars[i.Int64()], nil
+}
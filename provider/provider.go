@@ -2,19 +2,37 @@ package provider
 
 import (
 	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rbernardini/terraform-provider-mssql/mssql"
 )
 
+// Version of the provider, set by main from the release build flags
+var Version = "dev"
+
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
+			"connection_string": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("MSSQL_CONNECTION_STRING", nil),
+				Description:   "ADO, ODBC (odbc:...) or URL (sqlserver://...) connection string, used instead of endpoint, port and credentials",
+				ConflictsWith: []string{"endpoint", "port", "instance", "username", "password", "app_name", "workstation_id", "application_intent", "failover_partner", "encrypt", "trust_server_certificate", "certificate", "hostname_in_certificate"},
+			},
+
 			"endpoint": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("MSSQL_ENDPOINT", nil),
 				Description: "MSSQL server host",
 			},
@@ -26,18 +44,300 @@ func Provider() *schema.Provider {
 				Description: "MSSQL server port",
 			},
 
+			"instance": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_INSTANCE", nil),
+				Description: "MSSQL named instance, its port is resolved by the SQL Browser service",
+			},
+
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("MSSQL_USERNAME", nil),
 			},
 
 			"password": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("MSSQL_PASSWORD", nil),
 			},
 
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSSQL_DOMAIN", nil),
+				Description:  "Windows domain of username, authenticated with NTLM",
+				RequiredWith: []string{"username"},
+			},
+
+			"integrated_security": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("MSSQL_INTEGRATED_SECURITY", false),
+				Description:   "Authenticate as the Windows account running Terraform (SSPI), Windows only",
+				ConflictsWith: []string{"username", "password", "connection_string", "azure_login", "access_token", "exec_token_provider"},
+			},
+
+			"access_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("MSSQL_ACCESS_TOKEN", nil),
+				Description:   "Azure AD access token acquired outside of the provider, used instead of username and password",
+				ConflictsWith: []string{"username", "password", "connection_string", "azure_login", "exec_token_provider"},
+			},
+
+			"exec_token_provider": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "Command printing an access token, used instead of username and password",
+				ConflictsWith: []string{"username", "password", "connection_string", "azure_login", "access_token", "integrated_security"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"args": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"env": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"timeout_sec": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  60,
+						},
+					},
+				},
+			},
+
+			"password_key_vault_secret_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("MSSQL_PASSWORD_KEY_VAULT_SECRET_ID", nil),
+				Description:   "Azure Key Vault secret holding the password of username, read with the azure_login credential",
+				ConflictsWith: []string{"password", "password_vault", "password_aws_secrets_manager", "connection_string", "access_token", "integrated_security"},
+				RequiredWith:  []string{"username"},
+			},
+
+			"password_vault": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "HashiCorp Vault KV secret holding the password of username",
+				ConflictsWith: []string{"password", "password_key_vault_secret_id", "password_aws_secrets_manager", "connection_string", "access_token", "integrated_security"},
+				RequiredWith:  []string{"username"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("VAULT_ADDR", nil),
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc("VAULT_TOKEN", nil),
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("VAULT_NAMESPACE", nil),
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "API path of the secret, e.g. secret/data/sql for KV version 2",
+						},
+						"field": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "password",
+						},
+					},
+				},
+			},
+
+			"password_aws_secrets_manager": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "AWS Secrets Manager secret holding the password of username",
+				ConflictsWith: []string{"password", "password_key_vault_secret_id", "password_vault", "connection_string", "access_token", "integrated_security"},
+				RequiredWith:  []string{"username"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"secret_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name or ARN of the secret",
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"field": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Field of the JSON secret holding the password, the whole secret if empty",
+						},
+					},
+				},
+			},
+
+			"azure_login": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "Azure AD authentication, used instead of username and password",
+				ConflictsWith: []string{"password", "connection_string"},
+				Elem:          azureLoginResource(),
+			},
+
+			"app_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					if v := os.Getenv("MSSQL_APP_NAME"); v != "" {
+						return v, nil
+					}
+					return "terraform-provider-mssql/" + Version, nil
+				},
+				Description: "Application name of the sessions, shown in sys.dm_exec_sessions and audits",
+			},
+
+			"workstation_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_WORKSTATION_ID", nil),
+				Description: "Workstation ID (host name) of the sessions, defaults to the host running Terraform",
+			},
+
+			"application_intent": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSSQL_APPLICATION_INTENT", nil),
+				Description:  "ReadWrite or ReadOnly, to connect to a readable secondary of an availability group listener",
+				ValidateFunc: validation.StringInSlice([]string{"ReadWrite", "ReadOnly"}, false),
+			},
+
+			"failover_partner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_FAILOVER_PARTNER", nil),
+				Description: "Mirror server connected to when endpoint is down, for database mirroring pairs",
+			},
+
+			"failover_partner_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSSQL_FAILOVER_PARTNER_PORT", 1433),
+				Description:  "Port of failover_partner",
+				RequiredWith: []string{"failover_partner"},
+			},
+
+			"proxy": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"ALL_PROXY", "all_proxy"}, nil),
+				Description:   "SOCKS5 (socks5://) or HTTP CONNECT (http://, https://) proxy URL",
+				ConflictsWith: []string{"connection_string", "instance", "ssh_tunnel"},
+			},
+
+			"ssh_tunnel": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "Connect through an SSH bastion, the endpoint is resolved and dialed from the bastion",
+				ConflictsWith: []string{"connection_string", "instance"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  22,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"private_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"private_key_passphrase": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"use_agent": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"host_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Public key of the bastion in authorized_keys format, ~/.ssh/known_hosts is used when empty",
+						},
+						"insecure_ignore_host_key": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"encrypt": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSSQL_ENCRYPT", nil),
+				Description:  "Encryption of the connection: true (always), false (login only, the default) or disable",
+				ValidateFunc: validation.StringInSlice([]string{"true", "false", "disable"}, true),
+			},
+
+			"trust_server_certificate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_TRUST_SERVER_CERTIFICATE", false),
+				Description: "Skip the validation of the server certificate",
+			},
+
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_CERTIFICATE", nil),
+				Description: "CA certificate validating the server certificate, as a PEM file path or PEM content",
+			},
+
+			"hostname_in_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_HOSTNAME_IN_CERTIFICATE", nil),
+				Description: "Host name expected in the server certificate, defaults to endpoint",
+			},
+
 			"database": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -45,53 +345,580 @@ func Provider() *schema.Provider {
 			},
 
 			"max_conn_lifetime_sec": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_MAX_CONN_LIFETIME_SEC", 0),
+				Description: "Maximum time in seconds a connection is reused, connections are reused forever if <= 0",
+			},
+
+			"max_conn_idle_time_sec": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_MAX_CONN_IDLE_TIME_SEC", 0),
+				Description: "Maximum time in seconds a connection stays idle in the pool, unlimited if <= 0",
+			},
+
+			"keepalive_sec": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSSQL_KEEPALIVE_SEC", 30),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Interval in seconds of the TCP keepalive probes, and of the SSH keepalive requests through ssh_tunnel",
 			},
 
 			"max_open_conns": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_MAX_OPEN_CONNS", 0),
+				Description: "Maximum number of open connections per database, unlimited if <= 0",
+			},
+
+			"max_idle_conns": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_MAX_IDLE_CONNS", 2),
+				Description: "Maximum number of idle connections kept per database, none are kept if <= 0",
 			},
 
 			"connect_retry_timeout_sec": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  300,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_CONNECT_RETRY_TIMEOUT_SEC", 300),
+				Description: "Total time in seconds spent retrying to connect",
+			},
+
+			"dial_timeout_sec": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_DIAL_TIMEOUT_SEC", 15),
+				Description: "Timeout in seconds of each attempt to open a TCP connection",
+			},
+
+			"statement_timeout_sec": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_STATEMENT_TIMEOUT_SEC", 0),
+				Description: "Timeout in seconds of each statement or query, unlimited if 0",
+			},
+
+			"deadlock_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_DEADLOCK_RETRIES", 3),
+				Description: "Number of times a statement failing with a deadlock (1205) or lock timeout (1222) is retried",
+			},
+
+			"connect_max_attempts": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_CONNECT_MAX_ATTEMPTS", 0),
+				Description: "Maximum number of connection attempts, unlimited within connect_retry_timeout_sec if <= 0",
 			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"mysql_tables": DataSourceTables(),
+			"mysql_tables":                       DataSourceTables(),
+			"mssql_database_roles":               DataSourceDatabaseRoles(),
+			"mssql_server_principal_permissions": DataSourceServerPrincipalPermissions(),
+			"mssql_schemas":                      DataSourceSchemas(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"mssql_database": ResourceDatabase(),
-			"mssql_login":    ResourceLogin(),
-			"mssql_role":     ResourceRole(),
-			"mssql_user":     ResourceUser(),
-			"mssql_sql":      ResourceSql(),
+			"mssql_database":                     ResourceDatabase(),
+			"mssql_database_options":             ResourceDatabaseOptions(),
+			"mssql_database_copy":                ResourceDatabaseCopy(),
+			"mssql_database_secondary":           ResourceDatabaseSecondary(),
+			"mssql_database_snapshot":            ResourceDatabaseSnapshot(),
+			"mssql_filegroup":                    ResourceFilegroup(),
+			"mssql_database_file":                ResourceDatabaseFile(),
+			"mssql_partition_function":           ResourcePartitionFunction(),
+			"mssql_partition_scheme":             ResourcePartitionScheme(),
+			"mssql_login":                        ResourceLogin(),
+			"mssql_windows_login":                ResourceWindowsLogin(),
+			"mssql_azuread_login":                ResourceAzureADLogin(),
+			"mssql_role":                         ResourceRole(),
+			"mssql_database_role":                ResourceDatabaseRole(),
+			"mssql_database_role_member":         ResourceDatabaseRoleMember(),
+			"mssql_database_trigger":             ResourceDatabaseTrigger(),
+			"mssql_application_role":             ResourceApplicationRole(),
+			"mssql_database_permission":          ResourceDatabasePermission(),
+			"mssql_schema":                       ResourceSchema(),
+			"mssql_schema_permission":            ResourceSchemaPermission(),
+			"mssql_sequence":                     ResourceSequence(),
+			"mssql_synonym":                      ResourceSynonym(),
+			"mssql_table":                        ResourceTable(),
+			"mssql_index":                        ResourceIndex(),
+			"mssql_fulltext_catalog":             ResourceFulltextCatalog(),
+			"mssql_fulltext_index":               ResourceFulltextIndex(),
+			"mssql_table_trigger":                ResourceTableTrigger(),
+			"mssql_function":                     ResourceFunction(),
+			"mssql_stored_procedure":             ResourceStoredProcedure(),
+			"mssql_view":                         ResourceView(),
+			"mssql_assembly":                     ResourceAssembly(),
+			"mssql_broker_message_type":          ResourceBrokerMessageType(),
+			"mssql_broker_contract":              ResourceBrokerContract(),
+			"mssql_broker_queue":                 ResourceBrokerQueue(),
+			"mssql_broker_service":               ResourceBrokerService(),
+			"mssql_object_permission":            ResourceObjectPermission(),
+			"mssql_column_mask":                  ResourceColumnMask(),
+			"mssql_sensitivity_classification":   ResourceSensitivityClassification(),
+			"mssql_impersonate_permission":       ResourceImpersonatePermission(),
+			"mssql_server_role":                  ResourceServerRole(),
+			"mssql_server_role_member":           ResourceServerRoleMember(),
+			"mssql_server_trigger":               ResourceServerTrigger(),
+			"mssql_server_permission":            ResourceServerPermission(),
+			"mssql_server_audit":                 ResourceServerAudit(),
+			"mssql_server_audit_specification":   ResourceServerAuditSpecification(),
+			"mssql_database_firewall_rule":       ResourceDatabaseFirewallRule(),
+			"mssql_firewall_rule":                ResourceFirewallRule(),
+			"mssql_database_audit_specification": ResourceDatabaseAuditSpecification(),
+			"mssql_credential":                   ResourceCredential(),
+			"mssql_database_scoped_credential":   ResourceDatabaseScopedCredential(),
+			"mssql_master_key":                   ResourceMasterKey(),
+			"mssql_certificate":                  ResourceCertificate(),
+			"mssql_symmetric_key":                ResourceSymmetricKey(),
+			"mssql_asymmetric_key":               ResourceAsymmetricKey(),
+			"mssql_database_encryption":          ResourceDatabaseEncryption(),
+			"mssql_column_master_key":            ResourceColumnMasterKey(),
+			"mssql_column_encryption_key":        ResourceColumnEncryptionKey(),
+			"mssql_user":                         ResourceUser(),
+			"mssql_azuread_user":                 ResourceAzureADUser(),
+			"mssql_azuread_service_principal":    ResourceAzureADServicePrincipal(),
+			"mssql_sql":                          ResourceSql(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
+func azureLoginResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_TENANT_ID", nil),
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_CLIENT_ID", nil),
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("MSSQL_CLIENT_SECRET", nil),
+			},
+			"client_secret_key_vault_secret_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Azure Key Vault secret holding client_secret, read with the default credential chain",
+				ConflictsWith: []string{"azure_login.0.client_secret"},
+			},
+			"client_certificate_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to a PEM or PFX certificate authenticating the service principal",
+				ConflictsWith: []string{"azure_login.0.client_secret", "azure_login.0.client_certificate"},
+			},
+			"client_certificate": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "PEM certificate and private key, or base64 encoded PFX, authenticating the service principal",
+				ConflictsWith: []string{"azure_login.0.client_secret"},
+			},
+			"client_certificate_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"username": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Azure AD account exchanged for a token with its password (ActiveDirectoryPassword)",
+				RequiredWith:  []string{"azure_login.0.password"},
+				ConflictsWith: []string{"azure_login.0.client_secret", "azure_login.0.client_certificate", "azure_login.0.client_certificate_path"},
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"azure_login.0.username"},
+			},
+			"use_msi": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Authenticate with managed identity of the Azure host running Terraform",
+			},
+			"use_cli": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Authenticate with the account signed in with the Azure CLI",
+			},
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Authenticate the service principal with a federated OIDC token instead of a client secret",
+			},
+			"oidc_token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"oidc_token_file_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"use_default_credential": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Try environment, workload identity, managed identity and Azure CLI credentials in order",
+			},
+			"use_device_code": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Sign in interactively with the device code flow, e.g. for accounts requiring MFA",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "public",
+				Description: "The Azure cloud: public, usgovernment, china or german",
+			},
+			"active_directory_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Overrides the Azure AD authority of the environment",
+			},
+			"resource": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Overrides the audience of the SQL access token of the environment",
+			},
+		},
+	}
+}
+
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 
-	timeout, err := time.ParseDuration("30s")
-	if err != nil {
-		return nil, diag.FromErr(err)
+	timeout := time.Duration(d.Get("connect_retry_timeout_sec").(int)) * time.Second
+	if dsn := d.Get("connection_string").(string); dsn != "" {
+		client, diags := connectionStringConfigure(dsn, d.Get("database").(string), timeout)
+		if diags.HasError() {
+			return nil, diags
+		}
+		configureConnections(client, d)
+		client.MaxConnectAttempts = d.Get("connect_max_attempts").(int)
+		client.DeadlockRetries = d.Get("deadlock_retries").(int)
+		return client, diags
+	}
+
+	if d.Get("endpoint").(string) == "" {
+		return nil, diag.Errorf("one of endpoint or connection_string must be set")
 	}
+
 	client := &mssql.Connector{
 		Host:     d.Get("endpoint").(string),
 		Port:     d.Get("port").(int),
+		Instance: d.Get("instance").(string),
 		Database: d.Get("database").(string),
 		Timeout:  timeout, // d.Timeout(schema.TimeoutRead),
 
+		MaxConnectAttempts: d.Get("connect_max_attempts").(int),
+		DeadlockRetries:    d.Get("deadlock_retries").(int),
+
+		AppName:             d.Get("app_name").(string),
+		WorkstationID:       d.Get("workstation_id").(string),
+		ApplicationIntent:   d.Get("application_intent").(string),
+		FailoverPartner:     d.Get("failover_partner").(string),
+		FailoverPartnerPort: d.Get("failover_partner_port").(int),
+
+		Encrypt:                strings.ToLower(d.Get("encrypt").(string)),
+		TrustServerCertificate: d.Get("trust_server_certificate").(bool),
+		HostNameInCertificate:  d.Get("hostname_in_certificate").(string),
+
 		Login: &mssql.LoginUser{
 			Username: d.Get("username").(string),
 			Password: d.Get("password").(string),
+			Domain:   d.Get("domain").(string),
+		},
+	}
+
+	configureConnections(client, d)
+
+	if tunnel, ok := d.GetOk("ssh_tunnel.0"); ok {
+		client.Tunnel = parseSSHTunnel(tunnel.(map[string]interface{}))
+	}
+	if proxy := d.Get("proxy").(string); proxy != "" {
+		u, err := url.Parse(proxy)
+		if err != nil {
+			return nil, diag.Errorf("invalid proxy: %v", err)
+		}
+		switch u.Scheme {
+		case "socks5", "socks5h", "http", "https":
+		default:
+			return nil, diag.Errorf("invalid proxy %q: scheme must be one of socks5, socks5h, http or https", u.Redacted())
+		}
+		client.Proxy = u
+	}
+
+	certificate, err := certificateFile(d.Get("certificate").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	client.Certificate = certificate
+
+	azureLogin, ok := d.GetOk("azure_login.0")
+	if !ok && !otherAuthentication(d) && os.Getenv("MSSQL_CLIENT_ID") != "" {
+		// service principal injected in the environment, e.g. by CI secrets
+		azureLogin, ok = azureLoginDefaults()
+	}
+	var keyVaultLogin *mssql.AzureLogin
+	if ok {
+		login, diags := parseAzureLogin(azureLogin.(map[string]interface{}))
+		if diags.HasError() {
+			return nil, diags
+		}
+		keyVaultLogin = login
+		if client.Login.Username == "" {
+			client.Login = nil
+			client.AzureLogin = login
+		} else if d.Get("password_key_vault_secret_id").(string) == "" {
+			return nil, diag.Errorf("azure_login conflicts with username, unless password_key_vault_secret_id is set")
+		}
+	}
+
+	if secretID := d.Get("password_key_vault_secret_id").(string); secretID != "" {
+		if keyVaultLogin == nil {
+			keyVaultLogin = &mssql.AzureLogin{UseDefaultCredential: true}
+		}
+		client.Login.PasswordSource = &mssql.KeyVaultSecret{Login: keyVaultLogin, SecretID: secretID}
+	}
+	if vault, ok := d.GetOk("password_vault.0"); ok {
+		data := vault.(map[string]interface{})
+		client.Login.PasswordSource = &mssql.VaultSecret{
+			Address:   data["address"].(string),
+			Token:     data["token"].(string),
+			Namespace: data["namespace"].(string),
+			Path:      data["path"].(string),
+			Field:     data["field"].(string),
+		}
+	}
+	if secret, ok := d.GetOk("password_aws_secrets_manager.0"); ok {
+		data := secret.(map[string]interface{})
+		client.Login.PasswordSource = &mssql.AWSSecret{
+			SecretID: data["secret_id"].(string),
+			Region:   data["region"].(string),
+			Field:    data["field"].(string),
+		}
+	}
+
+	if d.Get("integrated_security").(bool) {
+		if runtime.GOOS != "windows" {
+			return nil, diag.Errorf("integrated_security is only supported when Terraform runs on Windows")
+		}
+		client.Login = nil
+		client.IntegratedSecurity = true
+	}
+
+	if token := d.Get("access_token").(string); token != "" {
+		client.Login = nil
+		client.AccessToken = token
+	}
+
+	if command, ok := d.GetOk("exec_token_provider.0"); ok {
+		client.Login = nil
+		client.TokenCommand = parseExecTokenProvider(command.(map[string]interface{}))
+	}
+
+	return client, diag.Diagnostics{}
+}
+
+// otherAuthentication tells whether an authentication other than azure_login is configured
+func otherAuthentication(d *schema.ResourceData) bool {
+	for _, key := range []string{"username", "access_token", "connection_string", "exec_token_provider"} {
+		if _, ok := d.GetOk(key); ok {
+			return true
+		}
+	}
+	return d.Get("integrated_security").(bool)
+}
+
+// azureLoginDefaults returns the azure_login block made of the default values of its arguments
+func azureLoginDefaults() (map[string]interface{}, bool) {
+	data := map[string]interface{}{}
+	for key, attr := range azureLoginResource().Schema {
+		value, err := attr.DefaultValue()
+		if err != nil || value == nil {
+			value = attr.ZeroValue()
+		}
+		data[key] = value
+	}
+	return data, true
+}
+
+func parseAzureLogin(data map[string]interface{}) (*mssql.AzureLogin, diag.Diagnostics) {
+	login := &mssql.AzureLogin{
+		TenantID:     data["tenant_id"].(string),
+		ClientID:     data["client_id"].(string),
+		ClientSecret: data["client_secret"].(string),
+
+		ClientCertificatePath:     data["client_certificate_path"].(string),
+		ClientCertificate:         data["client_certificate"].(string),
+		ClientCertificatePassword: data["client_certificate_password"].(string),
+
+		Username: data["username"].(string),
+		Password: data["password"].(string),
+
+		UseMSI: data["use_msi"].(bool),
+		UseCLI: data["use_cli"].(bool),
+
+		UseOIDC:           data["use_oidc"].(bool),
+		OIDCToken:         data["oidc_token"].(string),
+		OIDCTokenFilePath: data["oidc_token_file_path"].(string),
+
+		UseDefaultCredential: data["use_default_credential"].(bool),
+		UseDeviceCode:        data["use_device_code"].(bool),
+
+		Environment:             data["environment"].(string),
+		ActiveDirectoryEndpoint: data["active_directory_endpoint"].(string),
+		Resource:                data["resource"].(string),
+	}
+	if _, err := login.TokenResource(); err != nil {
+		return nil, diag.Errorf("azure_login: %v", err)
+	}
+
+	modes := 0
+	for _, enabled := range []bool{login.UseMSI, login.UseCLI, login.UseOIDC, login.UseDefaultCredential, login.UseDeviceCode} {
+		if enabled {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return nil, diag.Errorf("azure_login: only one of use_msi, use_cli, use_oidc, use_default_credential or use_device_code can be set")
+	}
+
+	if login.UseOIDC {
+		// AKS workload identity injects the identity to use in the environment
+		if login.TenantID == "" {
+			login.TenantID = os.Getenv("AZURE_TENANT_ID")
+		}
+		if login.ClientID == "" {
+			login.ClientID = os.Getenv("AZURE_CLIENT_ID")
+		}
+		if login.TenantID == "" || login.ClientID == "" {
+			return nil, diag.Errorf("azure_login: tenant_id and client_id are required with use_oidc")
+		}
+		return login, nil
+	}
+
+	if modes == 0 && login.Username != "" {
+		return login, nil
+	}
+
+	if secretID := data["client_secret_key_vault_secret_id"].(string); secretID != "" {
+		// the secret cannot be read with the service principal it authenticates
+		vault := &mssql.AzureLogin{UseDefaultCredential: true, Environment: login.Environment}
+		secret, err := vault.ReadKeyVaultSecret(secretID)
+		if err != nil {
+			return nil, diag.Errorf("azure_login: %v", err)
+		}
+		login.ClientSecret = secret
+	}
+
+	hasCredential := login.ClientSecret != "" || login.ClientCertificatePath != "" || login.ClientCertificate != ""
+	if modes == 0 && (login.TenantID == "" || login.ClientID == "" || !hasCredential) {
+		return nil, diag.Errorf("azure_login: tenant_id, client_id and one of client_secret or client_certificate are required unless use_msi, use_cli, use_oidc or use_default_credential is set")
+	}
+	return login, nil
+}
+
+func parseExecTokenProvider(data map[string]interface{}) *mssql.ExecTokenProvider {
+	provider := &mssql.ExecTokenProvider{
+		Command: data["command"].(string),
+		Env:     map[string]string{},
+		Timeout: time.Duration(data["timeout_sec"].(int)) * time.Second,
+	}
+	for _, arg := range data["args"].([]interface{}) {
+		provider.Args = append(provider.Args, arg.(string))
+	}
+	for key, value := range data["env"].(map[string]interface{}) {
+		provider.Env[key] = value.(string)
+	}
+	return provider
+}
+
+func parseSSHTunnel(data map[string]interface{}) *mssql.SSHTunnel {
+	return &mssql.SSHTunnel{
+		Host:                  data["host"].(string),
+		Port:                  data["port"].(int),
+		User:                  data["user"].(string),
+		Password:              data["password"].(string),
+		PrivateKey:            data["private_key"].(string),
+		PrivateKeyPassphrase:  data["private_key_passphrase"].(string),
+		UseAgent:              data["use_agent"].(bool),
+		HostKey:               data["host_key"].(string),
+		InsecureIgnoreHostKey: data["insecure_ignore_host_key"].(bool),
+	}
+}
+
+func configureConnections(client *mssql.Connector, d *schema.ResourceData) {
+	client.DialTimeout = time.Duration(d.Get("dial_timeout_sec").(int)) * time.Second
+	client.StatementTimeout = time.Duration(d.Get("statement_timeout_sec").(int)) * time.Second
+	client.MaxOpenConns = d.Get("max_open_conns").(int)
+	client.MaxIdleConns = d.Get("max_idle_conns").(int)
+	client.ConnMaxLifetime = time.Duration(d.Get("max_conn_lifetime_sec").(int)) * time.Second
+	client.ConnMaxIdleTime = time.Duration(d.Get("max_conn_idle_time_sec").(int)) * time.Second
+	client.KeepAlive = time.Duration(d.Get("keepalive_sec").(int)) * time.Second
+}
+
+// certificateFile returns the path of the CA certificate, the driver only reads certificates from files,
+// so inline PEM content is written to a temporary file kept for the life of the provider process
+func certificateFile(certificate string) (string, error) {
+	if !strings.Contains(certificate, "-----BEGIN") {
+		return certificate, nil
+	}
+
+	f, err := ioutil.TempFile("", "terraform-provider-mssql-*.pem")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.WriteString(certificate); err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// connectionStringConfigure builds the connector from a raw connection string. Host, database and
+// credentials are extracted from it, as resources rely on them for database switching and feature checks.
+func connectionStringConfigure(dsn string, database string, timeout time.Duration) (*mssql.Connector, diag.Diagnostics) {
+	config, err := mssql.ParseConnectionString(dsn)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if config.Database != "" {
+		database = config.Database
+	}
+	client := &mssql.Connector{
+		Host:     config.Host,
+		Port:     int(config.Port),
+		Database: database,
+		Timeout:  timeout,
+		DSN:      dsn,
+
+		Instance: config.Instance,
+		Login: &mssql.LoginUser{
+			Username: config.User,
+			Password: config.Password,
 		},
 	}
 
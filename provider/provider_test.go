@@ -36,6 +36,9 @@ func init() {
 		"provider": func() (*schema.Provider, error) {
 			return Provider(), nil
 		},
+		"mssql": func() (*schema.Provider, error) {
+			return Provider(), nil
+		},
 	}
 }
 
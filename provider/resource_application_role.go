@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceApplicationRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateApplicationRole,
+		ReadContext:   ReadApplicationRole,
+		UpdateContext: UpdateApplicationRole,
+		DeleteContext: DeleteApplicationRole,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportApplicationRole,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "In which database this role will be created, provider database by default",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password the application activates the role with",
+			},
+			"password_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, changing it sets the password again, e.g. after it was changed outside of Terraform",
+			},
+			"default_schema": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Schema of the objects the role references without schema name, dbo if not set",
+			},
+			"principal_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Principal ID of the role, used in resource ID so the role is tracked across renames",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateApplicationRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	role := new(model.ApplicationRole).Parse(d)
+	if role.Database == "" {
+		role.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateApplicationRole(ctx, role); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", role.Database, role.Name))
+	return ReadApplicationRole(ctx, d, meta)
+}
+
+func ReadApplicationRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	database, principalID, name, err := mssql.ParsePrincipalId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role, err := connector.GetApplicationRole(ctx, database, principalID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if role == nil {
+		log.Printf("[WARN] Application role (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", role.Database, role.PrincipalID))
+	return role.ToSchema(d)
+}
+
+func UpdateApplicationRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	role := new(model.ApplicationRole).Parse(d)
+
+	oldName, _ := d.GetChange("name")
+	var password, defaultSchema string
+	if d.HasChanges("password", "password_version") {
+		password = role.Password
+	}
+	if d.HasChange("default_schema") {
+		defaultSchema = role.DefaultSchema
+	}
+	err := connector.AlterApplicationRole(ctx, role.Database, oldName.(string), role.Name, password, defaultSchema)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadApplicationRole(ctx, d, meta)
+}
+
+func DeleteApplicationRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	role := new(model.ApplicationRole).Parse(d)
+
+	err := connector.DeleteApplicationRole(ctx, role.Database, role.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportApplicationRole(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadApplicationRole(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("application role '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceAssembly() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAssembly,
+		ReadContext:   ReadAssembly,
+		UpdateContext: UpdateAssembly,
+		DeleteContext: DeleteAssembly,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportAssembly,
+		},
+		CustomizeDiff: assemblyHashDiff,
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the assembly, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"file", "content_base64"},
+				Description:  "Path of the assembly DLL on the machine running Terraform",
+			},
+			"content_base64": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsBase64,
+				Description:  "Content of the assembly DLL, base64 encoded",
+			},
+			"permission_set": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SAFE",
+				ValidateFunc: validation.StringInSlice([]string{"SAFE", "EXTERNAL_ACCESS", "UNSAFE"}, false),
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Database principal owning the assembly, the current user by default",
+			},
+			"trusted": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Add the hash of the assembly to the trusted assemblies of the server, for CLR strict security",
+			},
+			"hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA2_512 hash of the assembly in hex, changes of the content are detected with",
+			},
+			"clr_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Strong name of the assembly, with its version, culture and public key token",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assembly_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// assemblyContent reads the assembly from the file or decodes the base64 content, whichever is set
+func assemblyContent(file, contentBase64 string) ([]byte, error) {
+	if file != "" {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading assembly file: %v", err)
+		}
+		return content, nil
+	}
+	return base64.StdEncoding.DecodeString(contentBase64)
+}
+
+// assemblyHashDiff plans an update when the content of the assembly is not the one loaded in the database
+func assemblyHashDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("file") || !d.NewValueKnown("content_base64") {
+		return d.SetNewComputed("hash")
+	}
+	content, err := assemblyContent(d.Get("file").(string), d.Get("content_base64").(string))
+	if err != nil {
+		return err
+	}
+	if hash := mssql.AssemblyHash(content); hash != d.Get("hash").(string) {
+		return d.SetNew("hash", hash)
+	}
+	return nil
+}
+
+func CreateAssembly(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	assembly := new(model.Assembly).Parse(d)
+	if assembly.Database == "" {
+		assembly.Database = defaultDatabase(connector)
+	}
+	content, err := assemblyContent(d.Get("file").(string), d.Get("content_base64").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	assembly.Content = content
+
+	if err := connector.CreateAssembly(ctx, assembly); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", assembly.Database, assembly.Name))
+	return ReadAssembly(ctx, d, meta)
+}
+
+func ReadAssembly(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid assembly ID '%s', expected database/name", d.Id())
+	}
+
+	assembly, err := connector.GetAssembly(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if assembly == nil {
+		log.Printf("[WARN] Assembly (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return assembly.ToSchema(d)
+}
+
+// UpdateAssembly trusts the new content before loading it, and distrusts the previous one once it is replaced
+func UpdateAssembly(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	assembly := new(model.Assembly).Parse(d)
+	oldHash, _ := d.GetChange("hash")
+	oldTrusted, _ := d.GetChange("trusted")
+
+	if d.HasChanges("hash", "trusted") {
+		content, err := assemblyContent(d.Get("file").(string), d.Get("content_base64").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if assembly.Trusted {
+			if err := connector.TrustAssembly(ctx, assembly.Database, assembly.Name, content); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange("hash") {
+			assembly.Content = content
+		}
+	}
+
+	if assembly.Content != nil || d.HasChange("permission_set") {
+		if err := connector.AlterAssembly(ctx, assembly); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("owner") && assembly.Owner != "" {
+		if err := connector.AlterAssemblyOwner(ctx, assembly.Database, assembly.Name, assembly.Owner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if oldTrusted.(bool) && (d.HasChange("hash") || !assembly.Trusted) && oldHash.(string) != "" {
+		if err := connector.DistrustAssembly(ctx, oldHash.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadAssembly(ctx, d, meta)
+}
+
+func DeleteAssembly(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	assembly := new(model.Assembly).Parse(d)
+
+	if err := connector.DeleteAssembly(ctx, assembly.Database, assembly.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if assembly.Trusted && assembly.Hash != "" {
+		if err := connector.DistrustAssembly(ctx, assembly.Hash); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ImportAssembly(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadAssembly(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("assembly '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testAccAssemblyFile is the path of a SAFE CLR assembly built for the .NET Framework, e.g. a class library without
+// dependencies, which the assembly tests load. They are skipped when MSSQL_TEST_ASSEMBLY is not set.
+func testAccAssemblyFile(t *testing.T) string {
+	file := os.Getenv("MSSQL_TEST_ASSEMBLY")
+	if file == "" {
+		t.Skip("MSSQL_TEST_ASSEMBLY must be set to the path of a CLR assembly for the assembly acceptance tests")
+	}
+	return file
+}
+
+func TestAccAssembly_basic(t *testing.T) {
+	file := testAccAssemblyFile(t)
+	var hash string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssemblyConfig_basic(file, "SAFE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_assembly.test", "id", "master/tf_acc_assembly"),
+					resource.TestCheckResourceAttr("mssql_assembly.test", "permission_set", "SAFE"),
+					resource.TestCheckResourceAttr("mssql_assembly.test", "trusted", "true"),
+					resource.TestCheckResourceAttrSet("mssql_assembly.test", "hash"),
+					resource.TestCheckResourceAttrSet("mssql_assembly.test", "clr_name"),
+					resource.TestCheckResourceAttrSet("mssql_assembly.test", "assembly_id"),
+				),
+			},
+			{
+				Config: testAccAssemblyConfig_basic(file, "EXTERNAL_ACCESS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_assembly.test", "permission_set", "EXTERNAL_ACCESS"),
+					testAccStoreAttr("mssql_assembly.test", "hash", &hash),
+				),
+			},
+			{
+				// The same content given as base64 is the same assembly, it is not loaded again
+				Config: testAccAssemblyConfig_base64(file, "EXTERNAL_ACCESS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("mssql_assembly.test", "hash", &hash),
+				),
+			},
+			{
+				ResourceName:      "mssql_assembly.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the content of the assembly is not read back
+				ImportStateVerifyIgnore: []string{"file", "content_base64"},
+			},
+		},
+	})
+}
+
+func testAccAssemblyConfig_basic(file, permissionSet string) string {
+	return fmt.Sprintf(`
+resource "mssql_assembly" "test" {
+		database       = "master"
+		name           = "tf_acc_assembly"
+		file           = %q
+		permission_set = "%s"
+		trusted        = true
+}`, file, permissionSet)
+}
+
+func testAccAssemblyConfig_base64(file, permissionSet string) string {
+	return fmt.Sprintf(`
+resource "mssql_assembly" "test" {
+		database       = "master"
+		name           = "tf_acc_assembly"
+		content_base64 = filebase64(%q)
+		permission_set = "%s"
+		trusted        = true
+}`, file, permissionSet)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceAsymmetricKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAsymmetricKey,
+		ReadContext:   ReadAsymmetricKey,
+		UpdateContext: UpdateAsymmetricKey,
+		DeleteContext: DeleteAsymmetricKey,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportAsymmetricKey,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the key, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"algorithm", "file"},
+				ValidateFunc: validation.StringInSlice([]string{"RSA_2048", "RSA_3072", "RSA_4096"}, false),
+				Description:  "Algorithm of the key the server generates",
+			},
+			"file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Path on the server of the strong name file or public key the key is loaded from",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password encrypting the private key, the database master key does if not set",
+			},
+			"private_key_encryption": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How the private key is encrypted, e.g. ENCRYPTED_BY_MASTER_KEY or NO_PRIVATE_KEY",
+			},
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asymmetric_key_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateAsymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.AsymmetricKey).Parse(d)
+	if key.Database == "" {
+		key.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateAsymmetricKey(ctx, key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", key.Database, key.Name))
+	return ReadAsymmetricKey(ctx, d, meta)
+}
+
+func ReadAsymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid asymmetric key ID '%s', expected database/name", d.Id())
+	}
+
+	key, err := connector.GetAsymmetricKey(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if key == nil {
+		log.Printf("[WARN] Asymmetric key (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return key.ToSchema(d)
+}
+
+func UpdateAsymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.AsymmetricKey).Parse(d)
+
+	if d.HasChange("password") {
+		oldPassword, _ := d.GetChange("password")
+		err := connector.AlterAsymmetricKeyPrivateKey(ctx, key.Database, key.Name, oldPassword.(string), key.Password)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadAsymmetricKey(ctx, d, meta)
+}
+
+func DeleteAsymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.AsymmetricKey).Parse(d)
+
+	err := connector.DeleteAsymmetricKey(ctx, key.Database, key.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportAsymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadAsymmetricKey(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("asymmetric key '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceAzureADLogin() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAzureADLogin,
+		ReadContext:   ReadAzureADLogin,
+		UpdateContext: UpdateAzureADLogin,
+		DeleteContext: DeleteAzureADLogin,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportAzureADLogin,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "User principal name, group or application display name",
+			},
+			"object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "Azure AD object ID to derive the login SID from, so the server does not need to query Microsoft Graph",
+			},
+			"sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Login SID, derived from the object ID, used as resource ID",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_database": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"default_language": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kill_sessions_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Kill active sessions of the login before dropping it",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateAzureADLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	login := new(model.AzureADLogin).Parse(data)
+
+	if err := connector.CreateAzureADLogin(ctx, login); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(login.Name)
+	return ReadAzureADLogin(ctx, data, meta)
+}
+
+func ReadAzureADLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+
+	// Imported IDs and the ID set on creation hold the login name
+	sid, name := data.Id(), ""
+	if !mssql.IsSid(sid) {
+		sid, name = "", data.Id()
+	}
+	login, err := connector.GetAzureADLogin(ctx, sid, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if login == nil {
+		log.Printf("[WARN] Azure AD login (%s) not found; removing from state", data.Id())
+		data.SetId("")
+		return nil
+	}
+
+	data.SetId(login.Sid)
+	return login.ToSchema(data)
+}
+
+func UpdateAzureADLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	login := new(model.AzureADLogin).Parse(data)
+
+	var defaultDatabase, defaultLanguage string
+	if data.HasChange("default_database") {
+		defaultDatabase = login.DefaultDatabase
+	}
+	if data.HasChange("default_language") {
+		defaultLanguage = login.DefaultLanguage
+	}
+	if err := connector.AlterLoginDefaults(ctx, login.Name, defaultDatabase, defaultLanguage); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if data.HasChange("disabled") {
+		if err := connector.SetLoginDisabled(ctx, login.Name, login.Disabled); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadAzureADLogin(ctx, data, meta)
+}
+
+func DeleteAzureADLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	name := data.Get("name").(string)
+
+	if data.Get("kill_sessions_on_destroy").(bool) {
+		if err := connector.KillLoginSessions(ctx, name); err != nil {
+			log.Printf("[WARN] Killing sessions of login %s: %s", name, err)
+		}
+	}
+
+	err := connector.DropLogin(ctx, name)
+	if err == nil {
+		data.SetId("")
+	}
+
+	return diag.FromErr(err)
+}
+
+func ImportAzureADLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := data.Id()
+	diags := ReadAzureADLogin(ctx, data, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if data.Id() == "" {
+		return nil, fmt.Errorf("azure AD login '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceAzureADServicePrincipal() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAzureADServicePrincipal,
+		ReadContext:   ReadAzureADServicePrincipal,
+		UpdateContext: UpdateAzureADServicePrincipal,
+		DeleteContext: DeleteAzureADServicePrincipal,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportAzureADServicePrincipal,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "In which database this user will be created",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the application or managed identity",
+			},
+			"client_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "Client (application) ID of the service principal, which the user SID is derived from",
+			},
+			"default_schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"principal_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Principal ID of the user in the database, used in resource ID so the user is tracked across renames",
+			},
+			"kill_sessions_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Kill active sessions of the user before dropping it",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateAzureADServicePrincipal(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	sp := new(model.AzureADServicePrincipal).Parse(data)
+
+	if err := connector.CreateAzureADUser(ctx, sp.User()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", sp.Database, sp.Name))
+	return ReadAzureADServicePrincipal(ctx, data, meta)
+}
+
+func ReadAzureADServicePrincipal(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	user, err := getAzureADUserById(ctx, connector, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if user == nil || user.Type != model.UserTypeExternalUser {
+		log.Printf("[WARN] Azure AD service principal (%s) not found; removing from state", data.Id())
+		data.SetId("")
+		return nil
+	}
+
+	sp := &model.AzureADServicePrincipal{
+		PrincipalID:   user.PrincipalID,
+		Database:      user.Database,
+		Name:          user.Name,
+		ClientId:      user.ObjectId,
+		DefaultSchema: user.DefaultSchema,
+	}
+	data.SetId(fmt.Sprintf("%s/%d", sp.Database, sp.PrincipalID))
+	return sp.ToSchema(data)
+}
+
+func UpdateAzureADServicePrincipal(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	sp := new(model.AzureADServicePrincipal).Parse(data)
+
+	if data.HasChange("name") {
+		oldName, _ := data.GetChange("name")
+		if err := connector.RenameUser(ctx, sp.Database, oldName.(string), sp.Name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("default_schema") && sp.DefaultSchema != "" {
+		if err := connector.AlterUserDefaultSchema(ctx, sp.Database, sp.Name, sp.DefaultSchema); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadAzureADServicePrincipal(ctx, data, meta)
+}
+
+func DeleteAzureADServicePrincipal(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	sp := new(model.AzureADServicePrincipal).Parse(data)
+
+	if data.Get("kill_sessions_on_destroy").(bool) {
+		if err := connector.KillUserSessions(ctx, sp.Database, sp.Name); err != nil {
+			log.Printf("[WARN] Killing sessions of user %s: %s", sp.Name, err)
+		}
+	}
+
+	err := connector.DropUser(ctx, sp.Database, sp.Name)
+	if err == nil {
+		data.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportAzureADServicePrincipal(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := data.Id()
+	diags := ReadAzureADServicePrincipal(ctx, data, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if data.Id() == "" {
+		return nil, fmt.Errorf("azure AD service principal '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceAzureADUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateAzureADUser,
+		ReadContext:   ReadAzureADUser,
+		UpdateContext: UpdateAzureADUser,
+		DeleteContext: DeleteAzureADUser,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportAzureADUser,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "In which database this user will be created",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "User principal name, group or application display name",
+			},
+			"object_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "Azure AD object ID to derive the user SID from, so the server does not need to query Microsoft Graph",
+			},
+			"is_group": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"object_id"},
+				Description:  "Whether object_id is a group, rather than a user or an application",
+			},
+			"default_schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"principal_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Principal ID of the user in the database, used in resource ID so the user is tracked across renames",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kill_sessions_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Kill active sessions of the user before dropping it",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateAzureADUser(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	user := new(model.AzureADUser).Parse(data)
+
+	if err := connector.CreateAzureADUser(ctx, user); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", user.Database, user.Name))
+	return ReadAzureADUser(ctx, data, meta)
+}
+
+func ReadAzureADUser(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	user, err := getAzureADUserById(ctx, connector, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if user == nil {
+		log.Printf("[WARN] Azure AD user (%s) not found; removing from state", data.Id())
+		data.SetId("")
+		return nil
+	}
+
+	data.SetId(fmt.Sprintf("%s/%d", user.Database, user.PrincipalID))
+	return user.ToSchema(data)
+}
+
+// getAzureADUserById resolves database/principal_id ID, or database/name of imported resources
+func getAzureADUserById(ctx context.Context, connector *mssql.Connector, id string) (*model.AzureADUser, error) {
+	database, principalID, name, err := mssql.ParsePrincipalId(id)
+	if err != nil {
+		return nil, err
+	}
+	return connector.GetAzureADUser(ctx, database, principalID, name)
+}
+
+func UpdateAzureADUser(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	user := new(model.AzureADUser).Parse(data)
+
+	if data.HasChange("name") {
+		oldName, _ := data.GetChange("name")
+		if err := connector.RenameUser(ctx, user.Database, oldName.(string), user.Name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("default_schema") && user.DefaultSchema != "" {
+		if err := connector.AlterUserDefaultSchema(ctx, user.Database, user.Name, user.DefaultSchema); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadAzureADUser(ctx, data, meta)
+}
+
+func DeleteAzureADUser(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(data, meta)
+	user := new(model.AzureADUser).Parse(data)
+
+	if data.Get("kill_sessions_on_destroy").(bool) {
+		if err := connector.KillUserSessions(ctx, user.Database, user.Name); err != nil {
+			log.Printf("[WARN] Killing sessions of user %s: %s", user.Name, err)
+		}
+	}
+
+	err := connector.DropUser(ctx, user.Database, user.Name)
+	if err == nil {
+		data.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportAzureADUser(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := data.Id()
+	diags := ReadAzureADUser(ctx, data, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if data.Id() == "" {
+		return nil, fmt.Errorf("azure AD user '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceBrokerContract() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateBrokerContract,
+		ReadContext:   ReadBrokerContract,
+		UpdateContext: UpdateBrokerContract,
+		DeleteContext: DeleteBrokerContract,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportBrokerContract,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the contract, provider database by default",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Case-sensitive name of the contract, usually a URI such as //app/etl/Contract",
+			},
+			"message": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"sent_by": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"INITIATOR", "TARGET", "ANY"}, false),
+						},
+					},
+				},
+				Description: "Message types exchanged in the conversations following the contract, contracts cannot be altered",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Database principal owning the contract, the current user by default",
+			},
+			"contract_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateBrokerContract(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	contract := new(model.BrokerContract).Parse(d)
+	if contract.Database == "" {
+		contract.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateBrokerContract(ctx, contract); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", contract.Database, contract.Name))
+	return ReadBrokerContract(ctx, d, meta)
+}
+
+func ReadBrokerContract(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid contract ID '%s', expected database/name", d.Id())
+	}
+
+	contract, err := connector.GetBrokerContract(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if contract == nil {
+		log.Printf("[WARN] Contract (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return contract.ToSchema(d)
+}
+
+func UpdateBrokerContract(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	contract := new(model.BrokerContract).Parse(d)
+
+	if d.HasChange("owner") && contract.Owner != "" {
+		if err := connector.AlterBrokerContractOwner(ctx, contract.Database, contract.Name, contract.Owner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadBrokerContract(ctx, d, meta)
+}
+
+func DeleteBrokerContract(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	contract := new(model.BrokerContract).Parse(d)
+
+	err := connector.DeleteBrokerContract(ctx, contract.Database, contract.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportBrokerContract(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadBrokerContract(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("contract '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
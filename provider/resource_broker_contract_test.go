@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrokerContract_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerContractConfig_basic(`"dbo"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_contract.test", "id", "master///tf-acc/Contract"),
+					resource.TestCheckResourceAttr("mssql_broker_contract.test", "message.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("mssql_broker_contract.test", "message.*", map[string]string{
+						"message_type": "//tf-acc/Request",
+						"sent_by":      "INITIATOR",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("mssql_broker_contract.test", "message.*", map[string]string{
+						"message_type": "//tf-acc/Reply",
+						"sent_by":      "TARGET",
+					}),
+					resource.TestCheckResourceAttr("mssql_broker_contract.test", "owner", "dbo"),
+				),
+			},
+			{
+				Config: testAccBrokerContractConfig_basic("mssql_role.owner.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_contract.test", "owner", "tf_acc_broker_owner"),
+				),
+			},
+			{
+				ResourceName:      "mssql_broker_contract.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccBrokerContractConfig_messageTypes = `
+resource "mssql_broker_message_type" "request" {
+		database = "master"
+		name     = "//tf-acc/Request"
+}
+
+resource "mssql_broker_message_type" "reply" {
+		database = "master"
+		name     = "//tf-acc/Reply"
+}
+`
+
+func testAccBrokerContractConfig_basic(owner string) string {
+	return testAccBrokerContractConfig_messageTypes + fmt.Sprintf(`
+resource "mssql_role" "owner" {
+		database = "master"
+		name     = "tf_acc_broker_owner"
+}
+
+resource "mssql_broker_contract" "test" {
+		database = "master"
+		name     = "//tf-acc/Contract"
+		owner    = %s
+
+		message {
+				message_type = mssql_broker_message_type.request.name
+				sent_by      = "INITIATOR"
+		}
+		message {
+				message_type = mssql_broker_message_type.reply.name
+				sent_by      = "TARGET"
+		}
+}`, owner)
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceBrokerMessageType() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateBrokerMessageType,
+		ReadContext:   ReadBrokerMessageType,
+		UpdateContext: UpdateBrokerMessageType,
+		DeleteContext: DeleteBrokerMessageType,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportBrokerMessageType,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the message type, provider database by default",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Case-sensitive name of the message type, usually a URI such as //app/etl/Request",
+			},
+			"validation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "EMPTY", "WELL_FORMED_XML", "VALID_XML"}, false),
+				Description:  "How the body of the messages is validated, VALID_XML against the XML schema collection",
+			},
+			"xml_schema_collection": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: sameObjectName,
+				Description:      "XML schema collection validating the messages, as schema.name",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Database principal owning the message type, the current user by default",
+			},
+			"message_type_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// sameObjectName ignores the brackets and the case of schema-qualified names read back quoted, dbo by default
+func sameObjectName(_, old, new string, _ *schema.ResourceData) bool {
+	normalize := func(name string) string {
+		name = strings.NewReplacer("[", "", "]", "").Replace(name)
+		if name != "" && !strings.Contains(name, ".") {
+			name = "dbo." + name
+		}
+		return name
+	}
+	return strings.EqualFold(normalize(old), normalize(new))
+}
+
+func CreateBrokerMessageType(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	messageType := new(model.BrokerMessageType).Parse(d)
+	if messageType.Database == "" {
+		messageType.Database = defaultDatabase(connector)
+	}
+	if err := validateMessageValidation(messageType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := connector.CreateBrokerMessageType(ctx, messageType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", messageType.Database, messageType.Name))
+	return ReadBrokerMessageType(ctx, d, meta)
+}
+
+func ReadBrokerMessageType(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid message type ID '%s', expected database/name", d.Id())
+	}
+
+	messageType, err := connector.GetBrokerMessageType(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if messageType == nil {
+		log.Printf("[WARN] Message type (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return messageType.ToSchema(d)
+}
+
+func UpdateBrokerMessageType(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	messageType := new(model.BrokerMessageType).Parse(d)
+	if err := validateMessageValidation(messageType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("validation", "xml_schema_collection") {
+		if err := connector.AlterBrokerMessageType(ctx, messageType); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("owner") && messageType.Owner != "" {
+		if err := connector.AlterBrokerMessageTypeOwner(ctx, messageType.Database, messageType.Name, messageType.Owner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadBrokerMessageType(ctx, d, meta)
+}
+
+// validateMessageValidation requires the XML schema collection with VALID_XML validation only
+func validateMessageValidation(messageType *model.BrokerMessageType) error {
+	if (messageType.Validation == "VALID_XML") != (messageType.XmlSchemaCollection != "") {
+		return fmt.Errorf("xml_schema_collection must be set with VALID_XML validation, and only with it")
+	}
+	return nil
+}
+
+func DeleteBrokerMessageType(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	messageType := new(model.BrokerMessageType).Parse(d)
+
+	err := connector.DeleteBrokerMessageType(ctx, messageType.Database, messageType.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportBrokerMessageType(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadBrokerMessageType(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("message type '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrokerMessageType_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerMessageTypeConfig_basic("NONE", `"dbo"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_message_type.test", "id", "master///tf-acc/Request"),
+					resource.TestCheckResourceAttr("mssql_broker_message_type.test", "validation", "NONE"),
+					resource.TestCheckResourceAttr("mssql_broker_message_type.test", "owner", "dbo"),
+					resource.TestCheckResourceAttrSet("mssql_broker_message_type.test", "message_type_id"),
+				),
+			},
+			{
+				Config: testAccBrokerMessageTypeConfig_basic("WELL_FORMED_XML", "mssql_role.owner.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_message_type.test", "validation", "WELL_FORMED_XML"),
+					resource.TestCheckResourceAttr("mssql_broker_message_type.test", "owner", "tf_acc_broker_owner"),
+				),
+			},
+			{
+				ResourceName:      "mssql_broker_message_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBrokerMessageTypeConfig_basic(validation, owner string) string {
+	return fmt.Sprintf(`
+resource "mssql_role" "owner" {
+		database = "master"
+		name     = "tf_acc_broker_owner"
+}
+
+resource "mssql_broker_message_type" "test" {
+		database   = "master"
+		name       = "//tf-acc/Request"
+		validation = "%s"
+		owner      = %s
+}`, validation, owner)
+}
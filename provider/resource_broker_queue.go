@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceBrokerQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateBrokerQueue,
+		ReadContext:   ReadBrokerQueue,
+		UpdateContext: UpdateBrokerQueue,
+		DeleteContext: DeleteBrokerQueue,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportBrokerQueue,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the queue, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the queue receives messages, it is disabled by poison message handling",
+			},
+			"retention": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Keep the messages sent and received in the queue until their conversation ends",
+			},
+			"poison_message_handling": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Disable the queue after five consecutive transactions receiving from it roll back",
+			},
+			"activation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"procedure": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: sameObjectName,
+							Description:      "Stored procedure processing the messages, as schema.name",
+						},
+						"max_queue_readers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 32767),
+							Description:  "Maximum number of instances of the procedure started simultaneously",
+						},
+						"execute_as": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "SELF",
+							DiffSuppressFunc: sameExecuteAs,
+							Description:      "SELF, OWNER or the database user the procedure executes as",
+						},
+					},
+				},
+				Description: "Stored procedure Service Broker starts to process the messages of the queue",
+			},
+			"object_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateBrokerQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	queue := new(model.BrokerQueue).Parse(d)
+	if queue.Database == "" {
+		queue.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateBrokerQueue(ctx, queue); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", queue.Database, queue.Schema, queue.Name))
+	return ReadBrokerQueue(ctx, d, meta)
+}
+
+func ReadBrokerQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid queue ID '%s', expected database/schema/name", d.Id())
+	}
+
+	queue, err := connector.GetBrokerQueue(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if queue == nil {
+		log.Printf("[WARN] Queue (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// SELF is read back as the user who created or altered the queue
+	state := new(model.BrokerQueue).Parse(d)
+	if queue.Activation != nil && state.Activation != nil && queue.Activation.ExecuteAs != "OWNER" &&
+		strings.EqualFold(state.Activation.ExecuteAs, "SELF") {
+		queue.Activation.ExecuteAs = state.Activation.ExecuteAs
+	}
+
+	return queue.ToSchema(d)
+}
+
+func UpdateBrokerQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	queue := new(model.BrokerQueue).Parse(d)
+
+	if err := connector.AlterBrokerQueue(ctx, queue, d.HasChange("activation")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadBrokerQueue(ctx, d, meta)
+}
+
+func DeleteBrokerQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	queue := new(model.BrokerQueue).Parse(d)
+
+	err := connector.DeleteBrokerQueue(ctx, queue.Database, queue.Schema, queue.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportBrokerQueue(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadBrokerQueue(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("queue '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrokerQueue_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerQueueConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "id", "master/dbo/tf_acc_queue"),
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "retention", "false"),
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "activation.#", "0"),
+					resource.TestCheckResourceAttrSet("mssql_broker_queue.test", "object_id"),
+				),
+			},
+			{
+				Config: testAccBrokerQueueConfig_activation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "retention", "true"),
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "poison_message_handling", "false"),
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "activation.#", "1"),
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "activation.0.max_queue_readers", "2"),
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "activation.0.execute_as", "OWNER"),
+				),
+			},
+			{
+				ResourceName:      "mssql_broker_queue.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBrokerQueueConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "retention", "false"),
+					resource.TestCheckResourceAttr("mssql_broker_queue.test", "activation.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBrokerQueueConfig_basic = `
+resource "mssql_broker_queue" "test" {
+		database = "master"
+		name     = "tf_acc_queue"
+}`
+
+const testAccBrokerQueueConfig_activation = `
+resource "mssql_stored_procedure" "activation" {
+		database = "master"
+		name     = "tf_acc_queue_activation"
+		body     = "SET NOCOUNT ON;"
+}
+
+resource "mssql_broker_queue" "test" {
+		database                = "master"
+		name                    = "tf_acc_queue"
+		retention               = true
+		poison_message_handling = false
+
+		activation {
+				procedure         = "dbo.${mssql_stored_procedure.activation.name}"
+				max_queue_readers = 2
+				execute_as        = "OWNER"
+		}
+}`
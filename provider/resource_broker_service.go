@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceBrokerService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateBrokerService,
+		ReadContext:   ReadBrokerService,
+		UpdateContext: UpdateBrokerService,
+		DeleteContext: DeleteBrokerService,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportBrokerService,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the service, provider database by default",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Case-sensitive name of the service, usually a URI such as //app/etl/Target",
+			},
+			"queue_schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "dbo",
+			},
+			"queue": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Queue receiving the messages of the service",
+			},
+			"contracts": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Contracts of the conversations the service is the target of, none for services only initiating conversations",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Database principal owning the service, the current user by default",
+			},
+			"service_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateBrokerService(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	service := new(model.BrokerService).Parse(d)
+	if service.Database == "" {
+		service.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateBrokerService(ctx, service); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", service.Database, service.Name))
+	return ReadBrokerService(ctx, d, meta)
+}
+
+func ReadBrokerService(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid service ID '%s', expected database/name", d.Id())
+	}
+
+	service, err := connector.GetBrokerService(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if service == nil {
+		log.Printf("[WARN] Service (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return service.ToSchema(d)
+}
+
+func UpdateBrokerService(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	service := new(model.BrokerService).Parse(d)
+
+	if d.HasChanges("queue_schema", "queue", "contracts") {
+		old, err := connector.GetBrokerService(ctx, service.Database, service.Name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if old == nil {
+			return diag.Errorf("service '%s' not found in database '%s'", service.Name, service.Database)
+		}
+		if err := connector.AlterBrokerService(ctx, old, service); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("owner") && service.Owner != "" {
+		if err := connector.AlterBrokerServiceOwner(ctx, service.Database, service.Name, service.Owner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadBrokerService(ctx, d, meta)
+}
+
+func DeleteBrokerService(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	service := new(model.BrokerService).Parse(d)
+
+	err := connector.DeleteBrokerService(ctx, service.Database, service.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportBrokerService(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadBrokerService(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("service '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrokerService_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrokerServiceConfig_basic("first", "[mssql_broker_contract.test.name]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_service.test", "id", "master///tf-acc/Target"),
+					resource.TestCheckResourceAttr("mssql_broker_service.test", "queue", "tf_acc_service_first"),
+					resource.TestCheckResourceAttr("mssql_broker_service.test", "contracts.#", "1"),
+					resource.TestCheckTypeSetElemAttr("mssql_broker_service.test", "contracts.*", "//tf-acc/ServiceContract"),
+					resource.TestCheckResourceAttrSet("mssql_broker_service.test", "service_id"),
+				),
+			},
+			{
+				// The messages of the service move to the other queue
+				Config: testAccBrokerServiceConfig_basic("second", "[]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_broker_service.test", "queue", "tf_acc_service_second"),
+					resource.TestCheckResourceAttr("mssql_broker_service.test", "contracts.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "mssql_broker_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBrokerServiceConfig_basic(queue, contracts string) string {
+	return fmt.Sprintf(`
+resource "mssql_broker_message_type" "test" {
+		database = "master"
+		name     = "//tf-acc/ServiceRequest"
+}
+
+resource "mssql_broker_contract" "test" {
+		database = "master"
+		name     = "//tf-acc/ServiceContract"
+
+		message {
+				message_type = mssql_broker_message_type.test.name
+				sent_by      = "ANY"
+		}
+}
+
+resource "mssql_broker_queue" "first" {
+		database = "master"
+		name     = "tf_acc_service_first"
+}
+
+resource "mssql_broker_queue" "second" {
+		database = "master"
+		name     = "tf_acc_service_second"
+}
+
+resource "mssql_broker_service" "test" {
+		database  = "master"
+		name      = "//tf-acc/Target"
+		queue     = mssql_broker_queue.%s.name
+		contracts = %s
+}`, queue, contracts)
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateCertificate,
+		ReadContext:   ReadCertificate,
+		UpdateContext: UpdateCertificate,
+		DeleteContext: DeleteCertificate,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportCertificate,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the certificate, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subject": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subject", "file"},
+				Description:  "Subject of the self-signed certificate created by the server",
+			},
+			"start_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"file"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: sameRFC3339Time,
+				Description:      "RFC 3339 date the self-signed certificate is valid from, now by default",
+			},
+			"expiry_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"file"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: sameRFC3339Time,
+				Description:      "RFC 3339 date the self-signed certificate expires, a year after start date by default",
+			},
+			"file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Path on the server of the certificate file, e.g. a backup of a certificate",
+			},
+			"private_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"file"},
+				Description:  "Path on the server of the private key file of the certificate",
+			},
+			"private_key_decryption_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ForceNew:     true,
+				RequiredWith: []string{"private_key_file"},
+				Description:  "Password the private key file is encrypted with",
+			},
+			"private_key_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password encrypting the private key in the database, the database master key does if not set",
+			},
+			"private_key_encryption": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How the private key is encrypted, e.g. ENCRYPTED_BY_MASTER_KEY or NO_PRIVATE_KEY",
+			},
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func sameRFC3339Time(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+func CreateCertificate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	cert := new(model.Certificate).Parse(d)
+	if cert.Database == "" {
+		cert.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateCertificate(ctx, cert); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cert.Database, cert.Name))
+	return ReadCertificate(ctx, d, meta)
+}
+
+func ReadCertificate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid certificate ID '%s', expected database/name", d.Id())
+	}
+
+	cert, err := connector.GetCertificate(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cert == nil {
+		log.Printf("[WARN] Certificate (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return cert.ToSchema(d)
+}
+
+func UpdateCertificate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	cert := new(model.Certificate).Parse(d)
+
+	if d.HasChange("private_key_password") {
+		oldPassword, _ := d.GetChange("private_key_password")
+		err := connector.AlterCertificatePrivateKey(ctx, cert.Database, cert.Name, oldPassword.(string), cert.PrivateKeyPassword)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadCertificate(ctx, d, meta)
+}
+
+func DeleteCertificate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	cert := new(model.Certificate).Parse(d)
+
+	err := connector.DeleteCertificate(ctx, cert.Database, cert.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportCertificate(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadCertificate(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("certificate '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceColumnEncryptionKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateColumnEncryptionKey,
+		ReadContext:   ReadColumnEncryptionKey,
+		UpdateContext: UpdateColumnEncryptionKey,
+		DeleteContext: DeleteColumnEncryptionKey,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportColumnEncryptionKey,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the key, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Description: "Values of the key encrypted by a column master key, two while rotating the column master key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_master_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "RSA_OAEP",
+						},
+						"encrypted_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(mssql.BinaryLiteral, "expected 0x and uppercase hexadecimal digits"),
+						},
+					},
+				},
+			},
+			"column_encryption_key_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateColumnEncryptionKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.ColumnEncryptionKey).Parse(d)
+	if key.Database == "" {
+		key.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateColumnEncryptionKey(ctx, key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", key.Database, key.Name))
+	return ReadColumnEncryptionKey(ctx, d, meta)
+}
+
+func ReadColumnEncryptionKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid column encryption key ID '%s', expected database/name", d.Id())
+	}
+
+	key, err := connector.GetColumnEncryptionKey(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if key == nil {
+		log.Printf("[WARN] Column encryption key (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return key.ToSchema(d)
+}
+
+// UpdateColumnEncryptionKey adds the new values before dropping the old ones, as the key needs a value at any time
+func UpdateColumnEncryptionKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.ColumnEncryptionKey).Parse(d)
+
+	oldValues, newValues := d.GetChange("value")
+	added := model.ParseColumnEncryptionKeyValues(newValues.(*schema.Set).Difference(oldValues.(*schema.Set)))
+	removed := model.ParseColumnEncryptionKeyValues(oldValues.(*schema.Set).Difference(newValues.(*schema.Set)))
+
+	// a key has one value per column master key, a changed value is dropped before being added again
+	for _, value := range removed {
+		if hasColumnMasterKey(added, value.ColumnMasterKey) {
+			if err := connector.DropColumnEncryptionKeyValue(ctx, key.Database, key.Name, value.ColumnMasterKey); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	for _, value := range added {
+		if err := connector.AddColumnEncryptionKeyValue(ctx, key.Database, key.Name, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	for _, value := range removed {
+		if !hasColumnMasterKey(added, value.ColumnMasterKey) {
+			if err := connector.DropColumnEncryptionKeyValue(ctx, key.Database, key.Name, value.ColumnMasterKey); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadColumnEncryptionKey(ctx, d, meta)
+}
+
+func hasColumnMasterKey(values []model.ColumnEncryptionKeyValue, columnMasterKey string) bool {
+	for _, value := range values {
+		if value.ColumnMasterKey == columnMasterKey {
+			return true
+		}
+	}
+	return false
+}
+
+func DeleteColumnEncryptionKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.ColumnEncryptionKey).Parse(d)
+
+	err := connector.DeleteColumnEncryptionKey(ctx, key.Database, key.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportColumnEncryptionKey(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadColumnEncryptionKey(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("column encryption key '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceColumnMask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateColumnMask,
+		ReadContext:   ReadColumnMask,
+		UpdateContext: UpdateColumnMask,
+		DeleteContext: DeleteColumnMask,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportColumnMask,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the table, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"table": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"column": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameMaskingFunction,
+				Description:      "Masking function, e.g. default(), email(), random(1, 100) or partial(1, \"XXXX\", 0)",
+			},
+			"unmask_principals": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Database principals granted UNMASK on the column, seeing it unmasked",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// sameMaskingFunction ignores the spaces the server adds to or removes from the masking function
+func sameMaskingFunction(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(old), ""), strings.Join(strings.Fields(new), ""))
+}
+
+func CreateColumnMask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	mask := new(model.ColumnMask).Parse(d)
+	if mask.Database == "" {
+		mask.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.SetColumnMask(ctx, mask); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", mask.Database, mask.Schema, mask.Table, mask.Column))
+
+	for _, principal := range mask.UnmaskPrincipals {
+		if err := connector.SetObjectPermission(ctx, mask.UnmaskPermission(principal)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadColumnMask(ctx, d, meta)
+}
+
+func ReadColumnMask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 4)
+	if len(parts) != 4 {
+		return diag.Errorf("invalid column mask ID '%s', expected database/schema/table/column", d.Id())
+	}
+
+	mask, err := connector.GetColumnMask(ctx, parts[0], parts[1], parts[2], parts[3])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if mask == nil {
+		log.Printf("[WARN] Column mask (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return mask.ToSchema(d)
+}
+
+func UpdateColumnMask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	mask := new(model.ColumnMask).Parse(d)
+
+	if d.HasChange("function") {
+		if err := connector.SetColumnMask(ctx, mask); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("unmask_principals") {
+		oldPrincipals, newPrincipals := d.GetChange("unmask_principals")
+		for _, principal := range oldPrincipals.(*schema.Set).Difference(newPrincipals.(*schema.Set)).List() {
+			if err := connector.RevokeObjectPermission(ctx, mask.UnmaskPermission(principal.(string))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, principal := range newPrincipals.(*schema.Set).Difference(oldPrincipals.(*schema.Set)).List() {
+			if err := connector.SetObjectPermission(ctx, mask.UnmaskPermission(principal.(string))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadColumnMask(ctx, d, meta)
+}
+
+func DeleteColumnMask(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	mask := new(model.ColumnMask).Parse(d)
+
+	for _, principal := range mask.UnmaskPrincipals {
+		if err := connector.RevokeObjectPermission(ctx, mask.UnmaskPermission(principal)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := connector.DropColumnMask(ctx, mask)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportColumnMask(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadColumnMask(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("column mask '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+// ResourceColumnMasterKey has no update, as column master keys cannot be altered
+func ResourceColumnMasterKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateColumnMasterKey,
+		ReadContext:   ReadColumnMasterKey,
+		DeleteContext: DeleteColumnMasterKey,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportColumnMasterKey,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the key, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_store_provider_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key store provider of the key, e.g. AZURE_KEY_VAULT or MSSQL_CERTIFICATE_STORE",
+			},
+			"key_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path of the key in the key store, e.g. the key identifier in Azure Key Vault",
+			},
+			"signature": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(mssql.BinaryLiteral, "expected 0x and uppercase hexadecimal digits"),
+				Description:  "Signature of the key path, allowing enclave computations",
+			},
+			"column_master_key_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateColumnMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.ColumnMasterKey).Parse(d)
+	if key.Database == "" {
+		key.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateColumnMasterKey(ctx, key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", key.Database, key.Name))
+	return ReadColumnMasterKey(ctx, d, meta)
+}
+
+func ReadColumnMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid column master key ID '%s', expected database/name", d.Id())
+	}
+
+	key, err := connector.GetColumnMasterKey(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if key == nil {
+		log.Printf("[WARN] Column master key (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return key.ToSchema(d)
+}
+
+func DeleteColumnMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.ColumnMasterKey).Parse(d)
+
+	err := connector.DeleteColumnMasterKey(ctx, key.Database, key.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportColumnMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadColumnMasterKey(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("column master key '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
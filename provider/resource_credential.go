@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateCredential,
+		ReadContext:   ReadCredential,
+		UpdateContext: UpdateCredential,
+		DeleteContext: DeleteCredential,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportCredential,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the credential, e.g. the container URL for backups to URL",
+			},
+			"identity": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Account name, or e.g. SHARED ACCESS SIGNATURE or Managed Identity",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Secret of the identity, not read back from the server",
+			},
+			"credential_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	credential := new(model.Credential).Parse(d)
+
+	if err := connector.CreateCredential(ctx, credential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.Name)
+	return ReadCredential(ctx, d, meta)
+}
+
+func ReadCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	credential, err := connector.GetCredential(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if credential == nil {
+		log.Printf("[WARN] Credential (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return credential.ToSchema(d)
+}
+
+func UpdateCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	credential := new(model.Credential).Parse(d)
+
+	// ALTER CREDENTIAL without SECRET clears the secret, the one in state is always sent
+	if err := connector.AlterCredential(ctx, credential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadCredential(ctx, d, meta)
+}
+
+func DeleteCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	err := connector.DeleteCredential(ctx, d.Id())
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadCredential(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("credential '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,14 +2,13 @@ package provider
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rbernardini/terraform-provider-mssql/model"
-	"github.com/rbernardini/terraform-provider-mssql/mssql"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -23,7 +22,12 @@ func ResourceDatabase() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: ImportDatabase,
 		},
-		Schema: map[string]*schema.Schema{
+
+		// scaling an Azure SQL database lasts long
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Hour),
+			Update: schema.DefaultTimeout(time.Hour),
+		}, Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -33,80 +37,152 @@ func ResourceDatabase() *schema.Resource {
 			"default_collation": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				//Default:  "SQL_Latin1_General_CP1_CI_AS",
 			},
 
+			"compatibility_level": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{100, 110, 120, 130, 140, 150, 160, 170}),
+				Description:  "Compatibility level of the database, server default by default",
+			},
+
+			"containment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "PARTIAL"}, false),
+			},
+
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Login owning the database, the provider login by default",
+			},
+
 			"options": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     schema.TypeString,
 			},
+
+			"service_objective": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"elastic_pool"},
+				Description:   "Azure SQL Database service objective, e.g. S0 or GP_Gen5_2, ElasticPool for databases of an elastic pool",
+			},
+
+			"elastic_pool": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"service_objective"},
+				Description:   "Azure SQL Database elastic pool of the database",
+			},
+
+			"max_size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Azure SQL Database maximum size of the database, in GB",
+			},
+
+			"kill_sessions_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Kill active sessions of the database before dropping it",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail the destroy of the database, to be unset and applied before destroying it",
+			},
+			"server": serverSchema(),
 		},
 	}
 }
 
 func CreateDatabase(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(data, meta)
 	database := new(model.Database).Parse(data)
 
-	stmtSQL := fmt.Sprintf("CREATE DATABASE [%s]", database.Name)
-	if database.DefaultCollation != "" {
-		stmtSQL += " COLLATE " + database.DefaultCollation
-	}
-	if len(database.Options) > 0 {
-		stmtSQL += " WITH "
-		for opt := range database.Options {
-			stmtSQL += fmt.Sprintf(" %s = %s,", opt, database.Options[opt].ValueOrSqlNull())
-		}
-		stmtSQL = strings.TrimRight(stmtSQL, ",")
+	if err := connector.CreateDatabase(ctx, database); err != nil {
+		return diag.FromErr(err)
 	}
 
-	err := connector.ExecContext(ctx, stmtSQL)
-	if err == nil {
-		data.SetId(database.Name)
-	}
-	return diag.FromErr(err)
+	data.SetId(database.Name)
+	return ReadDatabase(ctx, data, meta)
 }
 
 func ReadDatabase(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
-	database := new(model.Database).Parse(data)
-
-	stmtSQL := "SELECT name, collation_name FROM sys.databases WHERE name LIKE '" + data.Id() + "'"
-
-	log.Println("Executing statement:", stmtSQL)
-	var collation model.NullString
-	err := connector.QueryRowContext(ctx, stmtSQL, func(row *sql.Row) error {
-		return row.Scan(&database.Name, &collation)
-	})
+	connector := resourceConnector(data, meta)
+	database, err := connector.GetDatabase(ctx, data.Id())
 	if err != nil {
 		return diag.Diagnostics{diag.Diagnostic{
 			Summary: fmt.Sprintf("read database %s info", data.Id()),
 			Detail:  err.Error(),
 		}}
-	} else {
-		_, ok := data.GetOk("default_collation")
-		if ok {
-			// If desired collation value is defined, then import actual collation value
-			database.DefaultCollation = collation.ToString()
-		}
+	}
+	if database == nil {
+		log.Printf("[WARN] Database (%s) not found; removing from state", data.Id())
+		data.SetId("")
+		return nil
 	}
 
 	return database.ToSchema(data)
 }
 
 func UpdateDatabase(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(data, meta)
 	diags := diag.Diagnostics{}
 
 	database := new(model.Database).Parse(data)
 
 	if data.HasChanges("default_collation") && database.DefaultCollation != "" {
-		stmtSQL := fmt.Sprintf("ALTER DATABASE %s COLLATE %s", database.Name, database.DefaultCollation)
-		//diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: stmtSQL})
-		err := connector.ExecContext(ctx, stmtSQL)
-		if err != nil {
-			diags = diag.FromErr(err)
+		if err := connector.SetDatabaseCollation(ctx, database.Name, database.DefaultCollation); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if data.HasChange("compatibility_level") && database.CompatibilityLevel > 0 {
+		if err := connector.SetDatabaseCompatibilityLevel(ctx, database.Name, database.CompatibilityLevel); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if data.HasChange("containment") && database.Containment != "" {
+		if err := connector.SetDatabaseContainment(ctx, database.Name, database.Containment); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if data.HasChanges("service_objective", "elastic_pool", "max_size_gb") {
+		modify := &model.Database{Name: database.Name}
+		if data.HasChange("max_size_gb") {
+			modify.MaxSizeGB = database.MaxSizeGB
+		}
+		if data.HasChange("elastic_pool") && database.ElasticPool != "" {
+			modify.ElasticPool = database.ElasticPool
+		} else if data.HasChange("service_objective") {
+			// leaves the elastic pool when moving to a service objective
+			modify.ServiceObjective = database.ServiceObjective
+		}
+		if err := connector.ModifyDatabase(ctx, modify); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if data.HasChange("owner") && database.Owner != "" {
+		if err := connector.SetDatabaseOwner(ctx, database.Name, database.Owner); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
 
@@ -126,7 +202,6 @@ func UpdateDatabase(ctx context.Context, data *schema.ResourceData, meta interfa
 		for opt := range database.Options {
 			value := database.Options[opt].ValueOrSqlNull()
 			stmtSQL := fmt.Sprintf("ALTER DATABASE [%s] WITH %s = %s", database.Name, opt, value)
-			log.Println("Executing statement:", stmtSQL)
 			err := connector.ExecContext(ctx, stmtSQL)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
@@ -136,20 +211,28 @@ func UpdateDatabase(ctx context.Context, data *schema.ResourceData, meta interfa
 			}
 		}
 	}
-	if !diags.HasError() {
-		database.ToSchema(data)
+	if diags.HasError() {
+		return diags
 	}
 
-	return diags
+	return append(diags, ReadDatabase(ctx, data, meta)...)
 }
 
 func DeleteDatabase(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(data, meta)
+	name := data.Get("name").(string)
+	if data.Get("deletion_protection").(bool) {
+		return diag.Errorf("database %s: deletion_protection is enabled, unset it and apply before destroying the database", name)
+	}
 
-	stmtSQL := "DROP DATABASE " + data.Get("name").(string)
-	log.Println("Executing statement:", stmtSQL)
-	err := connector.ExecContext(ctx, stmtSQL)
+	connector.ReleaseDatabase(name)
+	if data.Get("kill_sessions_on_destroy").(bool) {
+		if err := connector.KillDatabaseSessions(ctx, name); err != nil {
+			log.Printf("[WARN] Killing sessions of database %s: %s", name, err)
+		}
+	}
 
+	err := connector.DropDatabase(ctx, name)
 	if err == nil {
 		data.SetId("")
 	}
@@ -157,11 +240,15 @@ func DeleteDatabase(ctx context.Context, data *schema.ResourceData, meta interfa
 }
 
 func ImportDatabase(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := data.Id()
 	diags := ReadDatabase(ctx, data, meta)
 
 	if diags.HasError() {
 		return nil, fmt.Errorf(diags[0].Summary)
 	}
+	if data.Id() == "" {
+		return nil, fmt.Errorf("database '%s' not found", id)
+	}
 
 	return []*schema.ResourceData{data}, nil
 }
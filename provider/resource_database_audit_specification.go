@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceDatabaseAuditSpecification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseAuditSpecification,
+		ReadContext:   ReadDatabaseAuditSpecification,
+		UpdateContext: UpdateDatabaseAuditSpecification,
+		DeleteContext: DeleteDatabaseAuditSpecification,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseAuditSpecification,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the specification, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"audit": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Server audit the specification writes to",
+			},
+			"action_groups": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"action_groups", "action"},
+				Description:  "Audited action groups, e.g. SCHEMA_OBJECT_CHANGE_GROUP",
+			},
+			"action": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"action_groups", "action"},
+				Description:  "Audited actions on a securable by a principal",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Audited action, e.g. SELECT, INSERT, UPDATE, DELETE or EXECUTE",
+						},
+						"class": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "OBJECT",
+							ValidateFunc: validation.StringInSlice([]string{"OBJECT", "SCHEMA", "DATABASE"}, false),
+							Description:  "Class of the securable, OBJECT, SCHEMA or DATABASE",
+						},
+						"schema": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Schema of the object, or the schema securable",
+						},
+						"object": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"principal": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Database principal whose actions are audited, public for everyone",
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func validateAuditActions(actions []model.AuditAction) diag.Diagnostics {
+	for _, a := range actions {
+		switch {
+		case a.Class == "OBJECT" && (a.Schema == "" || a.Object == ""):
+			return diag.Errorf("schema and object are required for action %s on OBJECT", a.Action)
+		case a.Class == "SCHEMA" && (a.Schema == "" || a.Object != ""):
+			return diag.Errorf("only schema is expected for action %s on SCHEMA", a.Action)
+		case a.Class == "DATABASE" && (a.Schema != "" || a.Object != ""):
+			return diag.Errorf("neither schema nor object is expected for action %s on DATABASE", a.Action)
+		}
+	}
+	return nil
+}
+
+func CreateDatabaseAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	spec := new(model.DatabaseAuditSpecification).Parse(d)
+	if spec.Database == "" {
+		spec.Database = defaultDatabase(connector)
+	}
+	if diags := validateAuditActions(spec.Actions); diags.HasError() {
+		return diags
+	}
+
+	if err := connector.CreateDatabaseAuditSpecification(ctx, spec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", spec.Database, spec.Name))
+	return ReadDatabaseAuditSpecification(ctx, d, meta)
+}
+
+func ReadDatabaseAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid database audit specification ID '%s', expected database/name", d.Id())
+	}
+
+	spec, err := connector.GetDatabaseAuditSpecification(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if spec == nil {
+		log.Printf("[WARN] Database audit specification (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return spec.ToSchema(d)
+}
+
+func UpdateDatabaseAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	spec := new(model.DatabaseAuditSpecification).Parse(d)
+	if diags := validateAuditActions(spec.Actions); diags.HasError() {
+		return diags
+	}
+
+	oldSpec := *spec
+	oldGroups, _ := d.GetChange("action_groups")
+	oldSpec.ActionGroups = make([]string, 0)
+	for _, group := range oldGroups.(*schema.Set).List() {
+		oldSpec.ActionGroups = append(oldSpec.ActionGroups, group.(string))
+	}
+	oldActions, _ := d.GetChange("action")
+	oldSpec.Actions = model.ParseAuditActions(oldActions.(*schema.Set))
+
+	if err := connector.AlterDatabaseAuditSpecification(ctx, &oldSpec, spec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseAuditSpecification(ctx, d, meta)
+}
+
+func DeleteDatabaseAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	spec := new(model.DatabaseAuditSpecification).Parse(d)
+
+	err := connector.DeleteDatabaseAuditSpecification(ctx, spec.Database, spec.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseAuditSpecification(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database audit specification '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceDatabaseCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseCopy,
+		ReadContext:   ReadDatabaseCopy,
+		UpdateContext: UpdateDatabaseCopy,
+		DeleteContext: DeleteDatabaseCopy,
+
+		// copying a large database lasts long
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_server": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the logical server of the source database, without .database.windows.net, the server of the copy by default",
+			},
+			"source_database": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_objective": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"elastic_pool"},
+				Description:   "Service objective of the copy, the one of the source by default",
+			},
+			"elastic_pool": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"service_objective"},
+				Description:   "Elastic pool of the copy",
+			},
+			"reseed_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary value, changing it drops the copy and copies the source again",
+			},
+			"kill_sessions_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Kill active sessions of the copy before dropping it",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateDatabaseCopy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	dbCopy := new(model.DatabaseCopy).Parse(d)
+
+	edition, err := connector.GetEngineEdition(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if edition != mssql.EngineEditionAzureSQLDatabase {
+		return diag.Errorf("database copy %s: database copies are supported by Azure SQL Database only", dbCopy.Name)
+	}
+
+	if err := connector.CopyDatabase(ctx, dbCopy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(dbCopy.Name)
+	return ReadDatabaseCopy(ctx, d, meta)
+}
+
+func ReadDatabaseCopy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	database, err := connector.GetDatabase(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if database == nil {
+		log.Printf("[WARN] Database copy (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// the source of the copy cannot be read back
+	return (&model.DatabaseCopy{Name: database.Name}).ToSchema(d)
+}
+
+// UpdateDatabaseCopy only changes kill_sessions_on_destroy in the state, every other change replaces the copy
+func UpdateDatabaseCopy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return ReadDatabaseCopy(ctx, d, meta)
+}
+
+func DeleteDatabaseCopy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	name := d.Id()
+
+	connector.ReleaseDatabase(name)
+	if d.Get("kill_sessions_on_destroy").(bool) {
+		if err := connector.KillDatabaseSessions(ctx, name); err != nil {
+			log.Printf("[WARN] Killing sessions of database %s: %s", name, err)
+		}
+	}
+
+	err := connector.DropDatabase(ctx, name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
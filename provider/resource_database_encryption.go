@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceDatabaseEncryption() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseEncryption,
+		ReadContext:   ReadDatabaseEncryption,
+		UpdateContext: UpdateDatabaseEncryption,
+		DeleteContext: DeleteDatabaseEncryption,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseEncryption,
+		},
+
+		// the encryption scan of a large database lasts long
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Database to encrypt",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Certificate of the master database encrypting the database encryption key",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AES_256",
+				ValidateFunc: validation.StringInSlice([]string{"AES_128", "AES_192", "AES_256", "TRIPLE_DES_3KEY"}, false),
+				Description:  "Algorithm of the database encryption key, changing it regenerates the key",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the database is encrypted",
+			},
+			"encryption_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Encryption state of the database, e.g. ENCRYPTED or UNENCRYPTED",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateDatabaseEncryption(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	encryption := new(model.DatabaseEncryption).Parse(d)
+
+	if err := connector.CreateDatabaseEncryption(ctx, encryption); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(encryption.Database)
+	return ReadDatabaseEncryption(ctx, d, meta)
+}
+
+func ReadDatabaseEncryption(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	encryption, err := connector.GetDatabaseEncryption(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if encryption == nil {
+		log.Printf("[WARN] Encryption key of database (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return encryption.ToSchema(d)
+}
+
+func UpdateDatabaseEncryption(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	encryption := new(model.DatabaseEncryption).Parse(d)
+
+	if d.HasChange("certificate") {
+		if err := connector.AlterDatabaseEncryptionCertificate(ctx, encryption.Database, encryption.Certificate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("algorithm") {
+		if err := connector.RegenerateDatabaseEncryptionKey(ctx, encryption.Database, encryption.Algorithm); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("enabled") {
+		if err := connector.SetDatabaseEncryption(ctx, encryption.Database, encryption.Enabled); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDatabaseEncryption(ctx, d, meta)
+}
+
+func DeleteDatabaseEncryption(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	err := connector.DeleteDatabaseEncryption(ctx, d.Id())
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseEncryption(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseEncryption(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("encryption key of database '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceDatabaseFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseFile,
+		ReadContext:   ReadDatabaseFile,
+		UpdateContext: UpdateDatabaseFile,
+		DeleteContext: DeleteDatabaseFile,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseFile,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the file, provider database by default",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Logical name of the file",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ROWS",
+				ValidateFunc: validation.StringInSlice([]string{"ROWS", "LOG", "FILESTREAM"}, false),
+			},
+			"filegroup": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Filegroup of the data file, the default filegroup by default",
+			},
+			"path": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: samePathIgnoringCase,
+				Description:      "Path of the file on the server, or of the directory of FILESTREAM files",
+			},
+			"size_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Size of the file, which can only grow",
+			},
+			"max_size_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+				Description:  "Maximum size of the file, -1 for unlimited",
+			},
+			"growth_mb": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"growth_percent"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+			"growth_percent": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"growth_mb"},
+				ValidateFunc:  validation.IntBetween(1, 100),
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// samePathIgnoringCase ignores the case of paths, Windows paths and FILESTREAM directories being case-insensitive
+func samePathIgnoringCase(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+func CreateDatabaseFile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	file := new(model.DatabaseFile).Parse(d)
+	if file.Database == "" {
+		file.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.AddDatabaseFile(ctx, file); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", file.Database, file.Name))
+	return ReadDatabaseFile(ctx, d, meta)
+}
+
+func ReadDatabaseFile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid database file ID '%s', expected database/name", d.Id())
+	}
+
+	file, err := connector.GetDatabaseFile(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if file == nil {
+		log.Printf("[WARN] Database file (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return file.ToSchema(d)
+}
+
+func UpdateDatabaseFile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	file := new(model.DatabaseFile).Parse(d)
+
+	current, err := connector.GetDatabaseFile(ctx, file.Database, file.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if current == nil {
+		return diag.Errorf("database file %s of database %s not found", file.Name, file.Database)
+	}
+
+	if err := connector.ModifyDatabaseFile(ctx, current, file); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseFile(ctx, d, meta)
+}
+
+func DeleteDatabaseFile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	file := new(model.DatabaseFile).Parse(d)
+
+	err := connector.RemoveDatabaseFile(ctx, file.Database, file.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseFile(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseFile(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database file '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
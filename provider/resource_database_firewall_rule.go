@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceDatabaseFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseFirewallRule,
+		ReadContext:   ReadDatabaseFirewallRule,
+		UpdateContext: UpdateDatabaseFirewallRule,
+		DeleteContext: DeleteDatabaseFirewallRule,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseFirewallRule,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the rule, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"end_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateDatabaseFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rule := new(model.DatabaseFirewallRule).Parse(d)
+	if rule.Database == "" {
+		rule.Database = defaultDatabase(connector)
+	}
+
+	edition, err := connector.GetEngineEdition(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if edition != mssql.EngineEditionAzureSQLDatabase {
+		return diag.Errorf("database firewall rule %s: firewall rules are supported by Azure SQL Database only", rule.Name)
+	}
+
+	if err := connector.SetDatabaseFirewallRule(ctx, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", rule.Database, rule.Name))
+	return ReadDatabaseFirewallRule(ctx, d, meta)
+}
+
+func ReadDatabaseFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid database firewall rule ID '%s', expected database/name", d.Id())
+	}
+
+	rule, err := connector.GetDatabaseFirewallRule(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if rule == nil {
+		log.Printf("[WARN] Database firewall rule (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return rule.ToSchema(d)
+}
+
+func UpdateDatabaseFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rule := new(model.DatabaseFirewallRule).Parse(d)
+
+	if err := connector.SetDatabaseFirewallRule(ctx, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseFirewallRule(ctx, d, meta)
+}
+
+func DeleteDatabaseFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rule := new(model.DatabaseFirewallRule).Parse(d)
+
+	err := connector.DeleteDatabaseFirewallRule(ctx, rule.Database, rule.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseFirewallRule(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database firewall rule '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
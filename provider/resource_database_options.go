@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceDatabaseOptions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseOptions,
+		ReadContext:   ReadDatabaseOptions,
+		UpdateContext: UpdateDatabaseOptions,
+		DeleteContext: DeleteDatabaseOptions,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseOptions,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the options, provider database by default",
+			},
+			"recovery_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"FULL", "BULK_LOGGED", "SIMPLE"}, false),
+			},
+			"read_committed_snapshot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_snapshot_isolation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"auto_close": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"auto_shrink": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"auto_update_statistics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"trustworthy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"broker_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether Service Broker delivers the messages of the database",
+			},
+			"page_verify": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CHECKSUM", "TORN_PAGE_DETECTION", "NONE"}, false),
+			},
+			"rollback_immediate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Roll back the open transactions of the database instead of waiting for them when setting options",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateDatabaseOptions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	options := new(model.DatabaseOptions).Parse(d)
+	if options.Database == "" {
+		options.Database = defaultDatabase(connector)
+	}
+
+	current, err := connector.GetDatabaseOptions(ctx, options.Database)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if current == nil {
+		return diag.Errorf("database %s not found", options.Database)
+	}
+
+	// Options left out of the configuration keep their current value
+	if !configured(d, "read_committed_snapshot") {
+		options.ReadCommittedSnapshot = current.ReadCommittedSnapshot
+	}
+	if !configured(d, "allow_snapshot_isolation") {
+		options.AllowSnapshotIsolation = current.AllowSnapshotIsolation
+	}
+	if !configured(d, "auto_close") {
+		options.AutoClose = current.AutoClose
+	}
+	if !configured(d, "auto_shrink") {
+		options.AutoShrink = current.AutoShrink
+	}
+	if !configured(d, "auto_update_statistics") {
+		options.AutoUpdateStatistics = current.AutoUpdateStatistics
+	}
+	if !configured(d, "trustworthy") {
+		options.Trustworthy = current.Trustworthy
+	}
+	if !configured(d, "broker_enabled") {
+		options.BrokerEnabled = current.BrokerEnabled
+	}
+
+	if err := connector.SetDatabaseOptions(ctx, current, options, d.Get("rollback_immediate").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(options.Database)
+	return ReadDatabaseOptions(ctx, d, meta)
+}
+
+func ReadDatabaseOptions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	options, err := connector.GetDatabaseOptions(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if options == nil {
+		log.Printf("[WARN] Database (%s) of options not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return options.ToSchema(d)
+}
+
+func UpdateDatabaseOptions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	options := new(model.DatabaseOptions).Parse(d)
+
+	current, err := connector.GetDatabaseOptions(ctx, options.Database)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if current == nil {
+		return diag.Errorf("database %s not found", options.Database)
+	}
+
+	if err := connector.SetDatabaseOptions(ctx, current, options, d.Get("rollback_immediate").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseOptions(ctx, d, meta)
+}
+
+// DeleteDatabaseOptions only removes the options from the state, the database keeps them
+func DeleteDatabaseOptions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func ImportDatabaseOptions(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseOptions(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// configured reports whether the attribute is set in the configuration, telling unset Optional Computed
+// booleans from false
+func configured(d *schema.ResourceData, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	return !config.GetAttr(key).IsNull()
+}
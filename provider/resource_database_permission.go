@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+// permissionName matches permission names like VIEW DEFINITION, which cannot be quoted in statements
+var permissionName = regexp.MustCompile(`^[A-Z]+( [A-Z]+)*$`)
+
+func ResourceDatabasePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabasePermission,
+		ReadContext:   ReadDatabasePermission,
+		UpdateContext: UpdateDatabasePermission,
+		DeleteContext: DeleteDatabasePermission,
+		CustomizeDiff: authoritativeDiff("principals"),
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabasePermission,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the permission, provider database by default",
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the user or role the permission is granted or denied to",
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(permissionName, "must be an upper case permission name, e.g. VIEW DEFINITION"),
+				Description:  "Database scope permission, e.g. CONNECT, CREATE TABLE or EXECUTE",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.PermissionStateGrant,
+				ValidateFunc: validation.StringInSlice([]string{model.PermissionStateGrant, model.PermissionStateDeny}, false),
+			},
+			"with_grant_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Allow the principal to grant the permission to other principals, GRANT state only",
+			},
+			"authoritative": authoritativeSchema(),
+			"principals":    declaredSchema("Other principals this authoritative resource grants or denies the permission to, with the same options"),
+			"unmanaged":     unmanagedSchema(),
+			"server":        forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateDatabasePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.DatabasePermission).Parse(d)
+	if p.Database == "" {
+		p.Database = defaultDatabase(connector)
+	}
+	if p.WithGrantOption && p.State != model.PermissionStateGrant {
+		return diag.Errorf("with_grant_option requires state %s", model.PermissionStateGrant)
+	}
+
+	if err := connector.SetDatabasePermission(ctx, p); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := databasePermissionGrants(connector, p).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", p.Database, p.Principal, p.Permission))
+	return ReadDatabasePermission(ctx, d, meta)
+}
+
+func ReadDatabasePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid permission ID '%s', expected database/principal/permission", d.Id())
+	}
+
+	p, err := connector.GetDatabasePermission(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if p == nil {
+		log.Printf("[WARN] Database permission (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	diags := p.ToSchema(d)
+	if err := databasePermissionGrants(connector, p).read(ctx, d); err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+	return diags
+}
+
+func UpdateDatabasePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.DatabasePermission).Parse(d)
+
+	if err := databasePermissionGrants(connector, p).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabasePermission(ctx, d, meta)
+}
+
+func DeleteDatabasePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.DatabasePermission).Parse(d)
+
+	if err := databasePermissionGrants(connector, p).revokeDeclared(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := connector.RevokeDatabasePermission(ctx, p)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabasePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabasePermission(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database permission '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// databasePermissionGrants are the grants of the permission to other principals. They are revoked with CASCADE,
+// which also revokes what these principals granted further.
+func databasePermissionGrants(connector *mssql.Connector, p *model.DatabasePermission) unmanagedGrants {
+	return unmanagedGrants{
+		principal: p.Principal,
+		declared:  "principals",
+		list: func(ctx context.Context) ([]string, error) {
+			return connector.GetDatabasePermissionGrantees(ctx, p.Database, p.Permission, p.State)
+		},
+		grant: func(ctx context.Context, principal string) error {
+			other := *p
+			other.Principal = principal
+			return connector.SetDatabasePermission(ctx, &other)
+		},
+		revoke: func(ctx context.Context, principal string) error {
+			other := *p
+			other.Principal, other.WithGrantOption = principal, true
+			return connector.RevokeDatabasePermission(ctx, &other)
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceDatabaseRoleMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseRoleMember,
+		ReadContext:   ReadDatabaseRoleMember,
+		UpdateContext: UpdateDatabaseRoleMember,
+		DeleteContext: DeleteDatabaseRoleMember,
+		CustomizeDiff: authoritativeDiff("members"),
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseRoleMember,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the role, provider database by default",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role, custom or fixed like db_datareader",
+			},
+			"member": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the user or role added to the role",
+			},
+			"authoritative": authoritativeSchema(),
+			"members":       declaredSchema("Other members this authoritative resource adds to the role"),
+			"unmanaged":     unmanagedSchema(),
+			"server":        forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateDatabaseRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rm := new(model.DatabaseRoleMember).Parse(d)
+	if rm.Database == "" {
+		rm.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.AddDatabaseRoleMember(ctx, rm.Database, rm.Role, rm.Member); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := databaseRoleMembers(connector, rm).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", rm.Database, rm.Role, rm.Member))
+	return ReadDatabaseRoleMember(ctx, d, meta)
+}
+
+func ReadDatabaseRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rm, err := parseDatabaseRoleMemberId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isMember, err := connector.IsDatabaseRoleMember(ctx, rm.Database, rm.Role, rm.Member)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !isMember {
+		log.Printf("[WARN] Role membership (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	diags := rm.ToSchema(d)
+	if err := databaseRoleMembers(connector, rm).read(ctx, d); err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+	return diags
+}
+
+func UpdateDatabaseRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rm := new(model.DatabaseRoleMember).Parse(d)
+
+	if err := databaseRoleMembers(connector, rm).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseRoleMember(ctx, d, meta)
+}
+
+func DeleteDatabaseRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rm := new(model.DatabaseRoleMember).Parse(d)
+
+	if err := databaseRoleMembers(connector, rm).revokeDeclared(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	isMember, err := connector.IsDatabaseRoleMember(ctx, rm.Database, rm.Role, rm.Member)
+	if err == nil && isMember {
+		err = connector.DropDatabaseRoleMember(ctx, rm.Database, rm.Role, rm.Member)
+	}
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseRoleMember(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("role membership '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseDatabaseRoleMemberId splits database/role/member ID. Names are used rather than principal IDs,
+// because the membership of fixed roles is shared by several stacks which only know their names.
+func parseDatabaseRoleMemberId(id string) (*model.DatabaseRoleMember, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid role membership ID '%s', expected database/role/member", id)
+	}
+	return &model.DatabaseRoleMember{Database: parts[0], Role: parts[1], Member: parts[2]}, nil
+}
+
+// databaseRoleMembers are the declared and other members of the role
+func databaseRoleMembers(connector *mssql.Connector, rm *model.DatabaseRoleMember) unmanagedGrants {
+	return unmanagedGrants{
+		principal: rm.Member,
+		declared:  "members",
+		list: func(ctx context.Context) ([]string, error) {
+			return connector.GetDatabaseRoleMembersByName(ctx, rm.Database, rm.Role)
+		},
+		grant: func(ctx context.Context, member string) error {
+			return connector.AddDatabaseRoleMember(ctx, rm.Database, rm.Role, member)
+		},
+		revoke: func(ctx context.Context, member string) error {
+			return connector.DropDatabaseRoleMember(ctx, rm.Database, rm.Role, member)
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceDatabaseScopedCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseScopedCredential,
+		ReadContext:   ReadDatabaseScopedCredential,
+		UpdateContext: UpdateDatabaseScopedCredential,
+		DeleteContext: DeleteDatabaseScopedCredential,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseScopedCredential,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the credential, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Account name, or e.g. SHARED ACCESS SIGNATURE or Managed Identity",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Secret of the identity, not read back from the server",
+			},
+			"credential_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateDatabaseScopedCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	credential := new(model.DatabaseScopedCredential).Parse(d)
+	if credential.Database == "" {
+		credential.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateDatabaseScopedCredential(ctx, credential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", credential.Database, credential.Name))
+	return ReadDatabaseScopedCredential(ctx, d, meta)
+}
+
+func ReadDatabaseScopedCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	// credential names may be URLs, database names have no slash
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid credential ID '%s', expected database/name", d.Id())
+	}
+
+	credential, err := connector.GetDatabaseScopedCredential(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if credential == nil {
+		log.Printf("[WARN] Database scoped credential (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return credential.ToSchema(d)
+}
+
+func UpdateDatabaseScopedCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	credential := new(model.DatabaseScopedCredential).Parse(d)
+
+	// ALTER without SECRET clears the secret, the one in state is always sent
+	if err := connector.AlterDatabaseScopedCredential(ctx, credential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseScopedCredential(ctx, d, meta)
+}
+
+func DeleteDatabaseScopedCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	credential := new(model.DatabaseScopedCredential).Parse(d)
+
+	err := connector.DeleteDatabaseScopedCredential(ctx, credential.Database, credential.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseScopedCredential(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseScopedCredential(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database scoped credential '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceDatabaseSecondary() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseSecondary,
+		ReadContext:   ReadDatabaseSecondary,
+		UpdateContext: UpdateDatabaseSecondary,
+		DeleteContext: DeleteDatabaseSecondary,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseSecondary,
+		},
+
+		// seeding the secondary of a large database lasts long
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Primary database on the server of the resource",
+			},
+			"partner_server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the logical server of the secondary, without .database.windows.net",
+			},
+			"partner_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Endpoint of the partner server the failovers connect to, <partner_server>.database.windows.net by default",
+			},
+			"allow_connections": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ALL",
+				ValidateFunc: validation.StringInSlice([]string{"ALL", "NO"}, false),
+				Description:  "Whether the secondary is readable",
+			},
+			"service_objective": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Service objective of the secondary, the one of the primary by default",
+			},
+			"failover": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PLANNED",
+				ValidateFunc: validation.StringInSlice([]string{"PLANNED", "FORCED"}, false),
+				Description:  "Failover run when failover_version changes, FORCED allowing data loss",
+			},
+			"failover_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, changing it fails over to the current secondary",
+			},
+			"partner_database": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Role of the database on the server of the resource, PRIMARY or SECONDARY",
+			},
+			"replication_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateDatabaseSecondary(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	link := new(model.GeoReplicationLink).Parse(d)
+
+	edition, err := connector.GetEngineEdition(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if edition != mssql.EngineEditionAzureSQLDatabase {
+		return diag.Errorf("database secondary %s: geo-replication is supported by Azure SQL Database only", link.Database)
+	}
+
+	if err := connector.AddSecondary(ctx, link); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", link.Database, link.PartnerServer))
+	return ReadDatabaseSecondary(ctx, d, meta)
+}
+
+func ReadDatabaseSecondary(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid database secondary ID '%s', expected database/partner_server", d.Id())
+	}
+
+	link, err := connector.GetGeoReplicationLink(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if link == nil {
+		log.Printf("[WARN] Geo-replication link (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return link.ToSchema(d)
+}
+
+func UpdateDatabaseSecondary(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("failover_version") {
+		connector := resourceConnector(d, meta)
+		link := new(model.GeoReplicationLink).Parse(d)
+
+		secondary, database, partnerServer, err := secondaryConnector(ctx, d, connector, link)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		forced := d.Get("failover").(string) == "FORCED"
+		if err := secondary.FailoverDatabase(ctx, database, partnerServer, forced); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDatabaseSecondary(ctx, d, meta)
+}
+
+func DeleteDatabaseSecondary(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	link, err := connector.GetGeoReplicationLink(ctx, d.Get("database").(string), d.Get("partner_server").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the link is removed on the primary, which is the partner server after a failover
+	if link != nil && link.Role == model.GeoReplicationRoleSecondary {
+		local, err := connector.GetServerName(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = partnerConnector(d, connector).RemoveSecondary(ctx, link.PartnerDatabase, local)
+	} else if link != nil {
+		err = connector.RemoveSecondary(ctx, link.Database, link.PartnerServer)
+	}
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseSecondary(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseSecondary(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("geo-replication link '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// partnerConnector returns the connector of the partner server, with the credentials of the connector
+func partnerConnector(d *schema.ResourceData, connector *mssql.Connector) *mssql.Connector {
+	endpoint := d.Get("partner_endpoint").(string)
+	if endpoint == "" {
+		endpoint = d.Get("partner_server").(string) + ".database.windows.net"
+	}
+	return connector.WithServer(endpoint, 1433, "", nil)
+}
+
+// secondaryConnector returns the connector of the server of the current secondary, with the names of the secondary
+// database and of the server of the primary, for failovers
+func secondaryConnector(ctx context.Context, d *schema.ResourceData, connector *mssql.Connector, link *model.GeoReplicationLink) (*mssql.Connector, string, string, error) {
+	current, err := connector.GetGeoReplicationLink(ctx, link.Database, link.PartnerServer)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if current == nil {
+		return nil, "", "", fmt.Errorf("geo-replication link of database %s to server %s not found", link.Database, link.PartnerServer)
+	}
+	if current.Role == model.GeoReplicationRoleSecondary {
+		return connector, current.Database, current.PartnerServer, nil
+	}
+
+	local, err := connector.GetServerName(ctx)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return partnerConnector(d, connector), current.PartnerDatabase, local, nil
+}
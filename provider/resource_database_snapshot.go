@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceDatabaseSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseSnapshot,
+		ReadContext:   ReadDatabaseSnapshot,
+		DeleteContext: DeleteDatabaseSnapshot,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseSnapshot,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_database": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Directory of the sparse files of the snapshot, the one of each data file of the source by default",
+			},
+			"files": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Paths of the sparse files of the snapshot",
+			},
+			"server": forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateDatabaseSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	snapshot := new(model.DatabaseSnapshot).Parse(d)
+
+	if err := connector.CreateDatabaseSnapshot(ctx, snapshot); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(snapshot.Name)
+	return ReadDatabaseSnapshot(ctx, d, meta)
+}
+
+func ReadDatabaseSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	snapshot, err := connector.GetDatabaseSnapshot(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if snapshot == nil {
+		log.Printf("[WARN] Database snapshot (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return snapshot.ToSchema(d)
+}
+
+func DeleteDatabaseSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	connector.ReleaseDatabase(d.Id())
+	err := connector.DropDatabase(ctx, d.Id())
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseSnapshot(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database snapshot '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
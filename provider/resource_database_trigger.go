@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceDatabaseTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateDatabaseTrigger,
+		ReadContext:   ReadDatabaseTrigger,
+		UpdateContext: UpdateDatabaseTrigger,
+		DeleteContext: DeleteDatabaseTrigger,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportDatabaseTrigger,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the trigger, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(ddlEvent, "must be an uppercase DDL event type or group"),
+				},
+				Description: "DDL events or event groups firing the trigger, e.g. ALTER_TABLE or DDL_TABLE_VIEW_EVENTS",
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "T-SQL statements of the trigger, following AS",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"definition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CREATE TRIGGER statement read back from the database",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateDatabaseTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger := new(model.DatabaseTrigger).Parse(d)
+	if trigger.Database == "" {
+		trigger.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateDatabaseTrigger(ctx, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", trigger.Database, trigger.Name))
+	return ReadDatabaseTrigger(ctx, d, meta)
+}
+
+func ReadDatabaseTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid database trigger ID '%s', expected database/name", d.Id())
+	}
+
+	trigger, err := connector.GetDatabaseTrigger(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if trigger == nil {
+		log.Printf("[WARN] Database trigger (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if trigger.Body == "" {
+		// encrypted, or the definition could not be parsed
+		trigger.Body = d.Get("body").(string)
+	}
+
+	return trigger.ToSchema(d)
+}
+
+func UpdateDatabaseTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger := new(model.DatabaseTrigger).Parse(d)
+
+	var err error
+	if d.HasChanges("events", "body") {
+		err = connector.AlterDatabaseTrigger(ctx, trigger)
+	} else {
+		err = connector.SetDatabaseTriggerEnabled(ctx, trigger.Database, trigger.Name, trigger.Enabled)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadDatabaseTrigger(ctx, d, meta)
+}
+
+func DeleteDatabaseTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger := new(model.DatabaseTrigger).Parse(d)
+
+	err := connector.DeleteDatabaseTrigger(ctx, trigger.Database, trigger.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportDatabaseTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadDatabaseTrigger(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("database trigger '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceFilegroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateFilegroup,
+		ReadContext:   ReadFilegroup,
+		UpdateContext: UpdateFilegroup,
+		DeleteContext: DeleteFilegroup,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportFilegroup,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the filegroup, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  model.FilegroupTypeRows,
+				ValidateFunc: validation.StringInSlice([]string{
+					model.FilegroupTypeRows, model.FilegroupTypeFilestream, model.FilegroupTypeMemoryOptimized}, false),
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether tables and indexes are created in the filegroup by default, once it contains files",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the filegroup is read-only, once it contains files",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateFilegroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	fg := new(model.Filegroup).Parse(d)
+	if fg.Database == "" {
+		fg.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateFilegroup(ctx, fg); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", fg.Database, fg.Name))
+	return ReadFilegroup(ctx, d, meta)
+}
+
+func ReadFilegroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid filegroup ID '%s', expected database/name", d.Id())
+	}
+
+	fg, err := connector.GetFilegroup(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if fg == nil {
+		log.Printf("[WARN] Filegroup (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return fg.ToSchema(d)
+}
+
+func UpdateFilegroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	fg := new(model.Filegroup).Parse(d)
+
+	if err := connector.AlterFilegroup(ctx, fg, d.HasChange("default"), d.HasChange("read_only")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadFilegroup(ctx, d, meta)
+}
+
+func DeleteFilegroup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	fg := new(model.Filegroup).Parse(d)
+
+	err := connector.DeleteFilegroup(ctx, fg.Database, fg.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportFilegroup(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadFilegroup(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("filegroup '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateFirewallRule,
+		ReadContext:   ReadFirewallRule,
+		UpdateContext: UpdateFirewallRule,
+		DeleteContext: DeleteFirewallRule,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportFirewallRule,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"end_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rule := new(model.FirewallRule).Parse(d)
+
+	edition, err := connector.GetEngineEdition(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if edition != mssql.EngineEditionAzureSQLDatabase {
+		return diag.Errorf("firewall rule %s: firewall rules are supported by Azure SQL Database only", rule.Name)
+	}
+
+	if err := connector.SetFirewallRule(ctx, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(rule.Name)
+	return ReadFirewallRule(ctx, d, meta)
+}
+
+func ReadFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rule, err := connector.GetFirewallRule(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if rule == nil {
+		log.Printf("[WARN] Firewall rule (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return rule.ToSchema(d)
+}
+
+func UpdateFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rule := new(model.FirewallRule).Parse(d)
+
+	if err := connector.SetFirewallRule(ctx, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadFirewallRule(ctx, d, meta)
+}
+
+func DeleteFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	err := connector.DeleteFirewallRule(ctx, d.Id())
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportFirewallRule(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadFirewallRule(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("firewall rule '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
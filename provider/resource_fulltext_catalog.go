@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceFulltextCatalog() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateFulltextCatalog,
+		ReadContext:   ReadFulltextCatalog,
+		UpdateContext: UpdateFulltextCatalog,
+		DeleteContext: DeleteFulltextCatalog,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportFulltextCatalog,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the catalog, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"accent_sensitive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the catalog is accent sensitive, following the database collation by default. Changing it rebuilds the catalog",
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the catalog is the default of the database, for the full-text indexes created without catalog",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Database principal owning the catalog, the current user by default",
+			},
+			"catalog_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateFulltextCatalog(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	catalog := new(model.FulltextCatalog).Parse(d)
+	if catalog.Database == "" {
+		catalog.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateFulltextCatalog(ctx, catalog); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", catalog.Database, catalog.Name))
+	return ReadFulltextCatalog(ctx, d, meta)
+}
+
+func ReadFulltextCatalog(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid full-text catalog ID '%s', expected database/name", d.Id())
+	}
+
+	catalog, err := connector.GetFulltextCatalog(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if catalog == nil {
+		log.Printf("[WARN] Full-text catalog (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return catalog.ToSchema(d)
+}
+
+func UpdateFulltextCatalog(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	catalog := new(model.FulltextCatalog).Parse(d)
+
+	if d.HasChange("default") && !catalog.Default {
+		return diag.Errorf("full-text catalog '%s' stays the default of database '%s' until another catalog is made the default",
+			catalog.Name, catalog.Database)
+	}
+
+	if d.HasChange("accent_sensitive") && catalog.AccentSensitive != nil {
+		if err := connector.RebuildFulltextCatalog(ctx, catalog.Database, catalog.Name, *catalog.AccentSensitive); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("default") {
+		if err := connector.SetDefaultFulltextCatalog(ctx, catalog.Database, catalog.Name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("owner") && catalog.Owner != "" {
+		if err := connector.AlterFulltextCatalogOwner(ctx, catalog.Database, catalog.Name, catalog.Owner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadFulltextCatalog(ctx, d, meta)
+}
+
+func DeleteFulltextCatalog(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	catalog := new(model.FulltextCatalog).Parse(d)
+
+	err := connector.DeleteFulltextCatalog(ctx, catalog.Database, catalog.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportFulltextCatalog(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadFulltextCatalog(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("full-text catalog '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceFulltextIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateFulltextIndex,
+		ReadContext:   ReadFulltextIndex,
+		UpdateContext: UpdateFulltextIndex,
+		DeleteContext: DeleteFulltextIndex,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportFulltextIndex,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the table, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"table": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Table or indexed view of the full-text index, a table has at most one",
+			},
+			"key_index": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique, single-column and non-nullable index of the table identifying its rows",
+			},
+			"catalog": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Full-text catalog of the index, the default catalog of the database when not set",
+			},
+			"column": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"language": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: sameLanguage,
+							Description:      "Name of the word breaker language in sys.fulltext_languages, the default full-text language when not set",
+						},
+						"type_column": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Column holding the file extension of the documents stored in a varbinary column",
+						},
+					},
+				},
+				Description: "Columns of the full-text index",
+			},
+			"change_tracking": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AUTO",
+				ValidateFunc: validation.StringInSlice([]string{"AUTO", "MANUAL", "OFF"}, false),
+				Description:  "Propagate changes of the table to the index AUTO, MANUAL or not at all",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// sameLanguage ignores the case of the language names read back from sys.fulltext_languages
+func sameLanguage(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+func CreateFulltextIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	index := new(model.FulltextIndex).Parse(d)
+	if index.Database == "" {
+		index.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateFulltextIndex(ctx, index); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", index.Database, index.Schema, index.Table))
+	return ReadFulltextIndex(ctx, d, meta)
+}
+
+func ReadFulltextIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid full-text index ID '%s', expected database/schema/table", d.Id())
+	}
+
+	index, err := connector.GetFulltextIndex(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if index == nil {
+		log.Printf("[WARN] Full-text index (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// keep the columns in the order they are declared, and the languages left to the server default unset
+	state := new(model.FulltextIndex).Parse(d)
+	columnOrder := make(map[string]int)
+	for i, column := range state.Columns {
+		columnOrder[column.Name] = i
+	}
+	for i, column := range index.Columns {
+		if p, ok := columnOrder[column.Name]; ok && state.Columns[p].Language == "" {
+			index.Columns[i].Language = ""
+		}
+	}
+	sortByName(len(index.Columns), func(i int) string { return index.Columns[i].Name }, columnOrder,
+		func(i, j int) { index.Columns[i], index.Columns[j] = index.Columns[j], index.Columns[i] })
+
+	return index.ToSchema(d)
+}
+
+func UpdateFulltextIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	index := new(model.FulltextIndex).Parse(d)
+
+	old, err := connector.GetFulltextIndex(ctx, index.Database, index.Schema, index.Table)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if old == nil {
+		return diag.Errorf("full-text index on %s.%s not found in database '%s'", index.Schema, index.Table, index.Database)
+	}
+	if err := connector.AlterFulltextIndex(ctx, old, index); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadFulltextIndex(ctx, d, meta)
+}
+
+func DeleteFulltextIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	index := new(model.FulltextIndex).Parse(d)
+
+	err := connector.DeleteFulltextIndex(ctx, index.Database, index.Schema, index.Table)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportFulltextIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadFulltextIndex(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("full-text index '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
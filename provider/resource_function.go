@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceFunction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateFunction,
+		ReadContext:   ReadFunction,
+		UpdateContext: UpdateFunction,
+		DeleteContext: DeleteFunction,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportFunction,
+		},
+		CustomizeDiff: functionTypeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the function, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "Declarations of the parameters without the enclosing parentheses, e.g. @tenant_id int",
+			},
+			"returns": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "Return type of scalar functions, TABLE for inline table-valued functions, or the table variable of multi-statement ones",
+			},
+			"schemabinding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Bind the function to the schema of the objects it references, required by security policies",
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "Statements of the function following AS, BEGIN ... END or RETURN (SELECT ...) for inline functions",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SCALAR_FUNCTION, INLINE_TABLE_VALUED_FUNCTION or TABLE_VALUED_FUNCTION",
+			},
+			"definition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CREATE FUNCTION statement read back from the database",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// functionType tells the type of the function returning returns
+func functionType(returns string) string {
+	returns = strings.TrimSpace(returns)
+	switch {
+	case strings.EqualFold(returns, "TABLE"):
+		return "INLINE_TABLE_VALUED_FUNCTION"
+	case strings.HasPrefix(returns, "@"):
+		return "TABLE_VALUED_FUNCTION"
+	}
+	return "SCALAR_FUNCTION"
+}
+
+// functionTypeDiff replaces the function when it changes type, which ALTER FUNCTION can not do
+func functionTypeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("returns") {
+		return nil
+	}
+	old, new := d.GetChange("returns")
+	if functionType(old.(string)) == functionType(new.(string)) {
+		return nil
+	}
+	return d.ForceNew("returns")
+}
+
+func CreateFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	function := new(model.Function).Parse(d)
+	if function.Database == "" {
+		function.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateOrAlterFunction(ctx, function); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", function.Database, function.Schema, function.Name))
+	return ReadFunction(ctx, d, meta)
+}
+
+func ReadFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid function ID '%s', expected database/schema/name", d.Id())
+	}
+
+	function, err := connector.GetFunction(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if function == nil {
+		log.Printf("[WARN] Function (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// keep the parameters, return type and body as written while they create the definition read back
+	state := new(model.Function).Parse(d)
+	state.Schema, state.Name = function.Schema, function.Name
+	if function.Definition == "" || mssql.SameFunctionDefinition(state, function.Definition) {
+		function.Parameters, function.Returns, function.Body = state.Parameters, state.Returns, state.Body
+	}
+
+	return function.ToSchema(d)
+}
+
+func UpdateFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	function := new(model.Function).Parse(d)
+
+	if err := connector.CreateOrAlterFunction(ctx, function); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadFunction(ctx, d, meta)
+}
+
+func DeleteFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	function := new(model.Function).Parse(d)
+
+	err := connector.DeleteFunction(ctx, function.Database, function.Schema, function.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadFunction(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("function '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
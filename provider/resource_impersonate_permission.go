@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceImpersonatePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateImpersonatePermission,
+		ReadContext:   ReadImpersonatePermission,
+		DeleteContext: DeleteImpersonatePermission,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportImpersonatePermission,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.ImpersonateScopeUser,
+				ValidateFunc: validation.StringInSlice([]string{model.ImpersonateScopeUser, model.ImpersonateScopeLogin}, false),
+				Description:  "USER to impersonate a database user with EXECUTE AS USER, LOGIN for EXECUTE AS LOGIN",
+			},
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the users, provider database by default. Not used by LOGIN scope",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the user or login which may be impersonated",
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the user, role or login allowed to impersonate target",
+			},
+			"with_grant_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Allow the principal to grant the permission to other principals",
+			},
+			"server": forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateImpersonatePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.ImpersonatePermission).Parse(d)
+	if p.Scope == model.ImpersonateScopeUser && p.Database == "" {
+		p.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.GrantImpersonatePermission(ctx, p); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(impersonatePermissionId(p))
+	return ReadImpersonatePermission(ctx, d, meta)
+}
+
+func ReadImpersonatePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	id, err := parseImpersonatePermissionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	p, err := connector.GetImpersonatePermission(ctx, id.Scope, id.Database, id.Target, id.Principal)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if p == nil {
+		log.Printf("[WARN] Impersonate permission (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if p.Scope == model.ImpersonateScopeLogin {
+		// not part of the ID of logins, keep the configured one
+		p.Database = d.Get("database").(string)
+	}
+
+	return p.ToSchema(d)
+}
+
+func DeleteImpersonatePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.ImpersonatePermission).Parse(d)
+
+	err := connector.RevokeImpersonatePermission(ctx, p)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportImpersonatePermission(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadImpersonatePermission(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("impersonate permission '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// impersonatePermissionId is user/database/target/principal, or login/target/principal for LOGIN scope
+func impersonatePermissionId(p *model.ImpersonatePermission) string {
+	if p.Scope == model.ImpersonateScopeLogin {
+		return fmt.Sprintf("login/%s/%s", p.Target, p.Principal)
+	}
+	return fmt.Sprintf("user/%s/%s/%s", p.Database, p.Target, p.Principal)
+}
+
+func parseImpersonatePermissionId(id string) (*model.ImpersonatePermission, error) {
+	parts := strings.Split(id, "/")
+	switch {
+	case len(parts) == 3 && parts[0] == "login":
+		return &model.ImpersonatePermission{Scope: model.ImpersonateScopeLogin, Target: parts[1], Principal: parts[2]}, nil
+	case len(parts) == 4 && parts[0] == "user":
+		return &model.ImpersonatePermission{Scope: model.ImpersonateScopeUser, Database: parts[1], Target: parts[2], Principal: parts[3]}, nil
+	}
+	return nil, fmt.Errorf("invalid impersonate permission ID '%s', expected user/database/target/principal or login/target/principal", id)
+}
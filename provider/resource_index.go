@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateIndex,
+		ReadContext:   ReadIndex,
+		UpdateContext: UpdateIndex,
+		DeleteContext: DeleteIndex,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportIndex,
+		},
+		CustomizeDiff: clusteredIndexDiff,
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the table, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"table": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Table or view the index is defined on",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"clustered": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the index is clustered, making it nonclustered replaces it",
+			},
+			"unique": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"column": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"descending": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+				Description: "Key columns of the index, in order",
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Nonkey columns included at the leaf level of a nonclustered index",
+			},
+			"filter": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: sameSqlExpression,
+				Description:      "Predicate of a filtered nonclustered index, without WHERE",
+			},
+			"fill_factor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 100),
+				Description:  "Percentage of each leaf page filled when the index is built, 0 for the server default",
+			},
+			"compression": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "ROW", "PAGE"}, false),
+			},
+			"online": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Build the index ONLINE, keeping the table available. Not supported by all editions",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// clusteredIndexDiff replaces clustered indexes made nonclustered, which DROP_EXISTING cannot do
+func clusteredIndexDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("clustered") {
+		return nil
+	}
+	if old, _ := d.GetChange("clustered"); !old.(bool) {
+		return nil
+	}
+	return d.ForceNew("clustered")
+}
+
+func CreateIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	index := new(model.Index).Parse(d)
+	if index.Database == "" {
+		index.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateIndex(ctx, index); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", index.Database, index.Schema, index.Table, index.Name))
+	return ReadIndex(ctx, d, meta)
+}
+
+func ReadIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 4)
+	if len(parts) != 4 {
+		return diag.Errorf("invalid index ID '%s', expected database/schema/table/name", d.Id())
+	}
+
+	index, err := connector.GetIndex(ctx, parts[0], parts[1], parts[2], parts[3])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if index == nil {
+		log.Printf("[WARN] Index (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return index.ToSchema(d)
+}
+
+func UpdateIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	index := new(model.Index).Parse(d)
+
+	// online only applies to the next build
+	if d.HasChanges("clustered", "unique", "column", "include", "filter", "fill_factor", "compression") {
+		if err := connector.RecreateIndex(ctx, index); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadIndex(ctx, d, meta)
+}
+
+func DeleteIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	index := new(model.Index).Parse(d)
+
+	err := connector.DeleteIndex(ctx, index.Database, index.Schema, index.Table, index.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportIndex(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadIndex(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("index '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIndex_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_basic(false, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_index.test", "id", "master/dbo/tf_acc_index_table/IX_tf_acc_index"),
+					resource.TestCheckResourceAttr("mssql_index.test", "unique", "false"),
+					resource.TestCheckResourceAttr("mssql_index.test", "column.#", "1"),
+					resource.TestCheckResourceAttr("mssql_index.test", "include.#", "1"),
+					resource.TestCheckResourceAttr("mssql_index.test", "include.0", "value"),
+				),
+			},
+			{
+				Config: testAccIndexConfig_basic(true, 80),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_index.test", "unique", "true"),
+					resource.TestCheckResourceAttr("mssql_index.test", "fill_factor", "80"),
+					resource.TestCheckResourceAttr("mssql_index.test", "column.#", "1"),
+				),
+			},
+			{
+				Config: testAccIndexConfig_filtered,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_index.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("mssql_index.test", "column.1.name", "created_at"),
+					resource.TestCheckResourceAttr("mssql_index.test", "column.1.descending", "true"),
+					resource.TestCheckResourceAttr("mssql_index.test", "compression", "PAGE"),
+				),
+			},
+			{
+				ResourceName:            "mssql_index.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"online"},
+			},
+		},
+	})
+}
+
+const testAccIndexConfig_table = `
+resource "mssql_table" "test" {
+		database = "master"
+		name     = "tf_acc_index_table"
+
+		column {
+				name     = "id"
+				type     = "int"
+				nullable = false
+		}
+		column {
+				name = "code"
+				type = "varchar(20)"
+		}
+		column {
+				name = "value"
+				type = "nvarchar(200)"
+		}
+		column {
+				name = "created_at"
+				type = "datetime2(0)"
+		}
+
+		primary_key {
+				columns = ["id"]
+		}
+}
+`
+
+func testAccIndexConfig_basic(unique bool, fillFactor int) string {
+	return testAccIndexConfig_table + fmt.Sprintf(`
+resource "mssql_index" "test" {
+		database    = "master"
+		table       = mssql_table.test.name
+		name        = "IX_tf_acc_index"
+		unique      = %t
+		fill_factor = %d
+
+		column {
+				name = "code"
+		}
+		include = ["value"]
+}`, unique, fillFactor)
+}
+
+const testAccIndexConfig_filtered = testAccIndexConfig_table + `
+resource "mssql_index" "test" {
+		database    = "master"
+		table       = mssql_table.test.name
+		name        = "IX_tf_acc_index"
+		compression = "PAGE"
+		filter      = "code IS NOT NULL"
+
+		column {
+				name = "code"
+		}
+		column {
+				name       = "created_at"
+				descending = true
+		}
+}`
@@ -2,13 +2,15 @@ package provider
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rbernardini/terraform-provider-mssql/model"
 	"github.com/rbernardini/terraform-provider-mssql/mssql"
 )
@@ -19,79 +21,234 @@ func ResourceLogin() *schema.Resource {
 		ReadContext:   ReadLogin,
 		UpdateContext: UpdateLogin,
 		DeleteContext: DeleteLogin,
+		CustomizeDiff: customdiff.All(generatedPasswordDiff, unlockLoginDiff),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: ImportLogin,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    (&schema.Resource{Schema: loginSchema()}).CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeLoginStateV0,
 			},
-			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
-				StateFunc: func(src interface{}) string {
-					return "" // Do not store password in state, actually
-				},
-			},
-			"options": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     schema.TypeString,
+		},
+
+		Schema: loginSchema(),
+	}
+}
+
+// loginSid matches the SIDs of SQL logins, 16 bytes in hex notation
+var loginSid = regexp.MustCompile("^0[xX][0-9A-Fa-f]{32}$")
+
+// sameSid ignores the case of the hex digits, the server reports them in upper case
+func sameSid(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+func loginSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"sid": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			ConflictsWith:    []string{"external"},
+			ValidateFunc:     validation.StringMatch(loginSid, "expected 0x and 32 hexadecimal digits"),
+			DiffSuppressFunc: sameSid,
+			Description:      "Login SID, used as resource ID so the login is tracked across renames. Set it to recreate a SQL login with the SID of another server",
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			StateFunc: func(src interface{}) string {
+				return "" // Do not store password in state, actually
 			},
+			ConflictsWith: []string{"external", "generate_password"},
+			Description:   "Password of SQL logins, never stored in state, so changes are applied when password_version changes",
+		},
+		"password_version": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"password"},
+			Description:  "Arbitrary value, changing it sets the password again, e.g. for rotation",
+		},
+		"generate_password": generatePasswordSchema(),
+		"generated_password": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Password generated by generate_password",
+		},
+		"external": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Create Azure AD login FROM EXTERNAL PROVIDER (Azure SQL Database and Managed Instance only)",
 		},
+		"object_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			RequiredWith: []string{"external"},
+			Description:  "Azure AD object ID to derive the login SID from, so the server does not need to query Azure AD",
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"default_database": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"default_language": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"check_policy": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"external"},
+			Description:   "Enforce the Windows password policy of the server, SQL logins only",
+		},
+		"check_expiration": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"external"},
+			Description:   "Enforce the password expiration policy, requires check_policy",
+		},
+		"must_change": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Require a new password at the first connection, applied on creation only, requires check_expiration",
+		},
+		"disabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Disable the login, so that it cannot connect. Disabled logins keep their permissions",
+		},
+		"locked": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the SQL login is locked out by the password policy",
+		},
+		"unlock": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Unlock the SQL login whenever it is found locked",
+		},
+		"options": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     schema.TypeString,
+		},
+		"kill_sessions_on_destroy": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Kill active sessions of the login before dropping it",
+		},
+		"server": serverSchema(),
 	}
 }
 
 func CreateLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(data, meta)
 	login := new(model.Login).Parse(data)
-	stmtSQL := "CREATE LOGIN [" + login.Name + "]"
-	if login.Password != "" || len(login.Options) > 0 {
-		stmtSQL += " WITH "
-		if login.Password != "" {
-			stmtSQL += fmt.Sprintf(" PASSWORD = '%s', ", login.Password)
-		}
-		for opt := range login.Options {
-			value := login.Options[opt].ValueOrSqlNull()
-			log.Printf("option '%s' = '%s'", opt, value)
-			stmtSQL += fmt.Sprintf(" %s = %s,", opt, value)
-		}
-		stmtSQL = strings.TrimRight(stmtSQL, ",")
+	if login.External {
+		return createExternalLogin(ctx, connector, data, login)
+	}
+	if login.ObjectId != "" {
+		return diag.Errorf("login %s: object_id is supported by external logins only", login.Name)
 	}
 
-	log.Printf("Executing statement: %s", stmtSQL)
-	err := connector.ExecContext(ctx, stmtSQL)
-	if err == nil {
-		data.SetId(login.Name)
+	generated, err := generatePassword(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if generated != "" {
+		login.Password = generated
 	}
 
-	return diag.FromErr(err)
+	if login.MustChange && (login.Password == "" || login.CheckExpiration == nil || !*login.CheckExpiration) {
+		return diag.Errorf("login %s: must_change requires a password and check_expiration", login.Name)
+	}
+
+	if err = connector.CreateLogin(ctx, login); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(login.Name)
+	if err = data.Set("generated_password", generated); err != nil {
+		return diag.FromErr(err)
+	}
+	return ReadLogin(ctx, data, connector)
+}
+
+// createExternalLogin creates the Azure AD login of an external mssql_login, as mssql_azuread_login does
+func createExternalLogin(ctx context.Context, connector *mssql.Connector, data *schema.ResourceData, login *model.Login) diag.Diagnostics {
+	external := &model.AzureADLogin{
+		Name:            login.Name,
+		ObjectId:        login.ObjectId,
+		DefaultDatabase: login.DefaultDatabase,
+		DefaultLanguage: login.DefaultLanguage,
+		Disabled:        login.Disabled,
+	}
+	if err := connector.CreateAzureADLogin(ctx, external); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(login.Name)
+	return ReadLogin(ctx, data, connector)
 }
 
 func ReadLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(data, meta)
 	login := new(model.Login).Parse(data)
 
-	var defaultDatabase, defaultLanguage model.NullString
-	stmtSQL := "SELECT name, default_database_name, default_language_name FROM [master].[sys].[sql_logins] WHERE [name] = @name"
-	log.Printf("Executing statement: %s", stmtSQL)
-	err := connector.QueryRowContext(ctx,
-		stmtSQL,
-		func(r *sql.Row) error {
-			return r.Scan(&login.Name, &defaultDatabase, &defaultLanguage)
-		},
-		sql.Named("name", data.Id()),
-	)
+	// Legacy and imported IDs hold the login name
+	sid, name := data.Id(), ""
+	if !mssql.IsSid(sid) {
+		sid, name = "", data.Id()
+	}
+	actual, err := connector.GetLogin(ctx, sid, name)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if actual == nil {
+		log.Printf("[WARN] Login (%s) not found; removing from state", data.Id())
+		data.SetId("")
+		return nil
+	}
+
+	data.SetId(actual.Sid)
+	login.Name, login.Sid, login.Type, login.External = actual.Name, actual.Sid, actual.Type, actual.External
+	login.DefaultDatabase, login.DefaultLanguage = actual.DefaultDatabase, actual.DefaultLanguage
+	login.CheckPolicy, login.CheckExpiration = actual.CheckPolicy, actual.CheckExpiration
+	login.Disabled, login.Locked = actual.Disabled, actual.Locked
+	if err = data.Set("object_id", actual.ObjectId); err != nil {
+		return diag.FromErr(err)
+	}
+	defaultDatabase, defaultLanguage := actual.Options["default_database"], actual.Options["default_language"]
 
-	log.Printf("READ: name='%s', password='%s'", login.Name, login.Password)
+	log.Printf("READ: name='%s'", login.Name)
 
 	log.Println("Importing Options")
 
@@ -119,10 +276,75 @@ func ReadLogin(ctx context.Context, data *schema.ResourceData, meta interface{})
 }
 
 func UpdateLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(data, meta)
 	login := new(model.Login).Parse(data)
 	diags := diag.Diagnostics{}
 
+	if data.HasChange("name") {
+		oldName, _ := data.GetChange("name")
+		if err := connector.RenameLogin(ctx, oldName.(string), login.Name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("password_version") {
+		// password is not in state, the configuration holds the new one
+		password := configString(data, "password")
+		if password == "" {
+			return diag.Errorf("login %s: password_version requires password", login.Name)
+		}
+		if err := setLoginPassword(ctx, connector, login.Name, password); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("generate_password") {
+		// without the block any more, the login keeps the last generated password
+		generated, err := generatePassword(data)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if generated != "" {
+			if err = setLoginPassword(ctx, connector, login.Name, generated); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err = data.Set("generated_password", generated); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("disabled") {
+		if err := connector.SetLoginDisabled(ctx, login.Name, login.Disabled); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("locked") && !login.Locked {
+		checkExpiration := login.CheckExpiration != nil && *login.CheckExpiration
+		if err := connector.UnlockLogin(ctx, login.Name, configString(data, "password"), checkExpiration); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	changed := &model.Login{}
+	if data.HasChange("default_database") {
+		changed.DefaultDatabase = login.DefaultDatabase
+	}
+	if data.HasChange("default_language") {
+		changed.DefaultLanguage = login.DefaultLanguage
+	}
+	if data.HasChange("check_policy") {
+		changed.CheckPolicy = login.CheckPolicy
+	}
+	if data.HasChange("check_expiration") {
+		changed.CheckExpiration = login.CheckExpiration
+	}
+	changed.Name = login.Name
+	if err := connector.AlterLoginOptions(ctx, changed); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if data.HasChange("options") {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Warning,
@@ -138,9 +360,7 @@ func UpdateLogin(ctx context.Context, data *schema.ResourceData, meta interface{
 
 		for opt := range login.Options {
 			value := login.Options[opt].ValueOrSqlNull()
-			stmtSQL := fmt.Sprintf("ALTER LOGIN [%s] WITH %s = %s", login.Name, opt, value)
-			log.Printf("Executing stagement: %s", stmtSQL)
-			err := connector.ExecContext(ctx, stmtSQL)
+			err := connector.AlterLogin(ctx, login.Name, []string{fmt.Sprintf("%s = %s", opt, value)})
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Summary: fmt.Sprintf("MSSQL login %s option '%s' update", login.Name, opt),
@@ -154,16 +374,16 @@ func UpdateLogin(ctx context.Context, data *schema.ResourceData, meta interface{
 }
 
 func DeleteLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
-	name := data.Id()
+	connector := resourceConnector(data, meta)
+	name := data.Get("name").(string)
 
-	err := killSessionsForLogin(connector, ctx, name)
-	if err != nil {
-		return diag.FromErr(err)
+	if data.Get("kill_sessions_on_destroy").(bool) {
+		if err := connector.KillLoginSessions(ctx, name); err != nil {
+			log.Printf("[WARN] Killing sessions of login %s: %s", name, err)
+		}
 	}
 
-	stmtSQL := fmt.Sprintf("IF EXISTS (SELECT 1 FROM [master].[sys].[sql_logins] WHERE [name] = '%s') DROP LOGIN [%s]", name, name)
-	err = connector.ExecContext(ctx, stmtSQL)
+	err := connector.DropLogin(ctx, name)
 	if err == nil {
 		data.SetId("")
 	}
@@ -172,33 +392,80 @@ func DeleteLogin(ctx context.Context, data *schema.ResourceData, meta interface{
 }
 
 func ImportLogin(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := data.Id()
 	diags := ReadLogin(ctx, data, meta)
 	if diags.HasError() {
 		return nil, fmt.Errorf(diags[0].Summary)
 	}
+	if data.Id() == "" {
+		return nil, fmt.Errorf("login '%s' not found", id)
+	}
 
 	return []*schema.ResourceData{data}, nil
 }
 
-func killSessionsForLogin(c *mssql.Connector, ctx context.Context, name string) error {
-	// adapted from https://stackoverflow.com/a/5178097/38055
-	cmd := ` 
-          DECLARE sessionsToKill CURSOR FAST_FORWARD FOR
-            SELECT session_id
-            FROM sys.dm_exec_sessions
-            WHERE login_name = @name
-          OPEN sessionsToKill
-          DECLARE @sessionId INT
-          DECLARE @statement NVARCHAR(200)
-          FETCH NEXT FROM sessionsToKill INTO @sessionId
-          WHILE @@FETCH_STATUS = 0
-          BEGIN
-            PRINT 'Killing session ' + CAST(@sessionId AS NVARCHAR(20)) + ' for model ' + @name
-            SET @statement = 'KILL ' + CAST(@sessionId AS NVARCHAR(20))
-            EXEC sp_executesql @statement
-            FETCH NEXT FROM sessionsToKill INTO @sessionId
-          END
-          CLOSE sessionsToKill
-          DEALLOCATE sessionsToKill`
-	return c.ExecContext(ctx, cmd, sql.Named("name", name))
+// upgradeLoginStateV0 replaces the login name used as ID by version 0 with the login SID
+func upgradeLoginStateV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	connector, ok := meta.(*mssql.Connector)
+	name, _ := rawState["id"].(string)
+	if !ok || name == "" || mssql.IsSid(name) {
+		return rawState, nil
+	}
+
+	login, err := connector.GetLogin(ctx, "", name)
+	if err != nil || login == nil {
+		// Keep the name, Read falls back to lookup by name
+		log.Printf("[WARN] Login (%s) SID lookup failed during state upgrade: %v", name, err)
+		return rawState, nil
+	}
+
+	rawState["id"] = login.Sid
+	rawState["sid"] = login.Sid
+	return rawState, nil
+}
+
+// configString reads the attribute from the configuration, for attributes kept out of state
+func configString(data *schema.ResourceData, key string) string {
+	config := data.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return ""
+	}
+	value := config.GetAttr(key)
+	if value.IsNull() || !value.IsKnown() {
+		return ""
+	}
+	return value.AsString()
+}
+
+// configIsSet tells whether the argument is in the configuration, even empty, which the planned value cannot tell
+func configIsSet(data *schema.ResourceData, key string) bool {
+	config := data.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	return !config.GetAttr(key).IsNull()
+}
+
+func setLoginPassword(ctx context.Context, connector *mssql.Connector, name string, password string) error {
+	option := fmt.Sprintf("PASSWORD = '%s'", strings.ReplaceAll(password, "'", "''"))
+	return connector.AlterLogin(ctx, name, []string{option})
+}
+
+// unlockLoginDiff plans to unlock the login found locked when unlock is set
+func unlockLoginDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("unlock").(bool) || !d.Get("locked").(bool) {
+		return nil
+	}
+	return d.SetNew("locked", false)
+}
+
+// generatedPasswordDiff plans a new generated password when the generate_password block changes, e.g. its keepers
+func generatedPasswordDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("generate_password") {
+		return nil
+	}
+	if len(d.Get("generate_password").([]interface{})) == 0 {
+		return d.SetNew("generated_password", "")
+	}
+	return d.SetNewComputed("generated_password")
 }
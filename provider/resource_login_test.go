@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func TestAccLogin_renameOutsideTerraform(t *testing.T) {
+	var sid string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginConfig_basic("tf_acc_login"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "name", "tf_acc_login"),
+					testAccStoreAttr("mssql_login.test", "sid", &sid),
+				),
+			},
+			{
+				// Renamed login is still found by SID and renamed back in place, rather than recreated
+				PreConfig: testAccExec(t, "ALTER LOGIN [tf_acc_login] WITH NAME = [tf_acc_login_renamed]"),
+				Config:    testAccLoginConfig_basic("tf_acc_login"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "name", "tf_acc_login"),
+					resource.TestCheckResourceAttrPtr("mssql_login.test", "sid", &sid),
+					resource.TestCheckResourceAttrPtr("mssql_login.test", "id", &sid),
+				),
+			},
+			{
+				ResourceName:            "mssql_login.test",
+				ImportState:             true,
+				ImportStateId:           "tf_acc_login",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "kill_sessions_on_destroy", "must_change", "unlock"},
+			},
+		},
+	})
+}
+
+func TestAccLogin_options(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginConfig_options("master", "us_english", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "default_database", "master"),
+					resource.TestCheckResourceAttr("mssql_login.test", "default_language", "us_english"),
+					resource.TestCheckResourceAttr("mssql_login.test", "check_policy", "false"),
+					resource.TestCheckResourceAttr("mssql_login.test", "check_expiration", "false"),
+				),
+			},
+			{
+				// Altered in place
+				Config: testAccLoginConfig_options("tempdb", "British", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "default_database", "tempdb"),
+					resource.TestCheckResourceAttr("mssql_login.test", "default_language", "British"),
+					resource.TestCheckResourceAttr("mssql_login.test", "check_policy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogin_passwordVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginConfig_passwordVersion("Tf-Acc-Pa55word!", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "password", ""),
+				),
+			},
+			{
+				// Password is altered in place and still not stored
+				Config: testAccLoginConfig_passwordVersion("Tf-Acc-Pa55word-2!", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "password_version", "2"),
+					resource.TestCheckResourceAttr("mssql_login.test", "password", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogin_sid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginConfig_sid("0x5e33c6d9214c3a4d8f60b0f1e94a2e11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "id", "0x5E33C6D9214C3A4D8F60B0F1E94A2E11"),
+				),
+			},
+			{
+				// The server reports the SID in upper case
+				Config:   testAccLoginConfig_sid("0x5e33c6d9214c3a4d8f60b0f1e94a2e11"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccLogin_disabled(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginConfig_disabled(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "disabled", "true"),
+					resource.TestCheckResourceAttr("mssql_login.test", "locked", "false"),
+				),
+			},
+			{
+				Config: testAccLoginConfig_disabled(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_login.test", "disabled", "false"),
+				),
+			},
+			{
+				// Disabling outside Terraform shows up as a diff
+				PreConfig:          testAccExec(t, "ALTER LOGIN [tf_acc_login_disabled] DISABLE"),
+				Config:             testAccLoginConfig_disabled(false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogin_generatePassword(t *testing.T) {
+	var password string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoginConfig_generatePassword("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("mssql_login.test", "generated_password", regexp.MustCompile(`^.{24}$`)),
+					testAccStoreAttr("mssql_login.test", "generated_password", &password),
+				),
+			},
+			{
+				// Changed keepers generate a new password in place
+				Config: testAccLoginConfig_generatePassword("2"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["mssql_login.test"].Primary.Attributes["generated_password"] == password {
+							return fmt.Errorf("password was not generated again")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccLoginConfig_generatePassword(keeper string) string {
+	return fmt.Sprintf(`
+resource "mssql_login" "test" {
+		name = "tf_acc_login_generated"
+		generate_password {
+			length  = 24
+			keepers = {
+				rotation = "%s"
+			}
+		}
+}`, keeper)
+}
+
+func testAccLoginConfig_sid(sid string) string {
+	return fmt.Sprintf(`
+resource "mssql_login" "test" {
+		name     = "tf_acc_login_sid"
+		sid      = "%s"
+		password = "Tf-Acc-Pa55word!"
+}`, sid)
+}
+
+func testAccLoginConfig_disabled(disabled bool) string {
+	return fmt.Sprintf(`
+resource "mssql_login" "test" {
+		name     = "tf_acc_login_disabled"
+		password = "Tf-Acc-Pa55word!"
+		disabled = %t
+}`, disabled)
+}
+
+func testAccLoginConfig_passwordVersion(password string, version string) string {
+	return fmt.Sprintf(`
+resource "mssql_login" "test" {
+		name             = "tf_acc_login_rotated"
+		password         = "%s"
+		password_version = "%s"
+}`, password, version)
+}
+
+func testAccLoginConfig_options(database string, language string, checkPolicy bool) string {
+	return fmt.Sprintf(`
+resource "mssql_login" "test" {
+		name             = "tf_acc_login_options"
+		password         = "Tf-Acc-Pa55word!"
+		default_database = "%s"
+		default_language = "%s"
+		check_policy     = %t
+}`, database, language, checkPolicy)
+}
+
+func testAccLoginConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "mssql_login" "test" {
+		name     = "%s"
+		password = "Tf-Acc-Pa55word!"
+}`, name)
+}
+
+// testAccExec runs the statement on the acceptance test server, to change objects outside Terraform
+func testAccExec(t *testing.T, stmtSQL string) func() {
+	return func() {
+		connector := TestAccProvider.Meta().(*mssql.Connector)
+		if err := connector.ExecContext(context.Background(), stmtSQL); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// testAccCheckExec runs the statement as a check, e.g. failing with THROW when the objects are not as expected
+func testAccCheckExec(t *testing.T, stmtSQL string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		testAccExec(t, stmtSQL)()
+		return nil
+	}
+}
+
+// testAccStoreAttr saves the attribute value for comparison in later steps
+func testAccStoreAttr(rn string, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+
+		*value, ok = rs.Primary.Attributes[key]
+		if !ok || *value == "" {
+			return fmt.Errorf("%s: attribute '%s' not found", rn, key)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceMasterKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateMasterKey,
+		ReadContext:   ReadMasterKey,
+		UpdateContext: UpdateMasterKey,
+		DeleteContext: DeleteMasterKey,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportMasterKey,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "In which database the master key will be created, provider database by default",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password encrypting the master key, optional in Azure SQL Database",
+			},
+			"regenerate_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"password"},
+				Description:  "Arbitrary value, changing it regenerates the master key and everything it protects",
+			},
+			"encrypted_by_server": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the master key is also encrypted by the service master key, so it is opened automatically",
+			},
+			"key_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.MasterKey).Parse(d)
+	if key.Database == "" {
+		key.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateMasterKey(ctx, key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(key.Database)
+	return ReadMasterKey(ctx, d, meta)
+}
+
+func ReadMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key, err := connector.GetMasterKey(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if key == nil {
+		log.Printf("[WARN] Master key of database (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return key.ToSchema(d)
+}
+
+func UpdateMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.MasterKey).Parse(d)
+	oldPassword, _ := d.GetChange("password")
+
+	err := connector.AlterMasterKey(ctx, key, oldPassword.(string), d.HasChange("regenerate_version"), d.HasChange("encrypted_by_server"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadMasterKey(ctx, d, meta)
+}
+
+func DeleteMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	err := connector.DropMasterKey(ctx, d.Id())
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportMasterKey(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadMasterKey(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("master key of database '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
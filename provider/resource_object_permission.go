@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceObjectPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateObjectPermission,
+		ReadContext:   ReadObjectPermission,
+		UpdateContext: UpdateObjectPermission,
+		DeleteContext: DeleteObjectPermission,
+		CustomizeDiff: authoritativeDiff("principals"),
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportObjectPermission,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the object, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"object": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the table, view, procedure or function",
+			},
+			"columns": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Columns the permission is limited to, e.g. for SELECT or UPDATE, the whole object if not set",
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the user or role the permission is granted or denied to",
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(permissionName, "must be an upper case permission name, e.g. SELECT"),
+				Description:  "Permission on the object, e.g. SELECT, UPDATE or EXECUTE",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.PermissionStateGrant,
+				ValidateFunc: validation.StringInSlice([]string{model.PermissionStateGrant, model.PermissionStateDeny}, false),
+			},
+			"with_grant_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Allow the principal to grant the permission to other principals, GRANT state only",
+			},
+			"authoritative": authoritativeSchema(),
+			"principals":    declaredSchema("Other principals this authoritative resource grants or denies the permission to, with the same options"),
+			"unmanaged":     unmanagedSchema(),
+			"server":        forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateObjectPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.ObjectPermission).Parse(d)
+	if p.Database == "" {
+		p.Database = defaultDatabase(connector)
+	}
+	if p.WithGrantOption && p.State != model.PermissionStateGrant {
+		return diag.Errorf("with_grant_option requires state %s", model.PermissionStateGrant)
+	}
+
+	if err := connector.SetObjectPermission(ctx, p); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := objectPermissionGrants(connector, p).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s", p.Database, p.Schema, p.Object, p.Principal, p.Permission))
+	return ReadObjectPermission(ctx, d, meta)
+}
+
+func ReadObjectPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 5)
+	if len(parts) != 5 {
+		return diag.Errorf("invalid permission ID '%s', expected database/schema/object/principal/permission", d.Id())
+	}
+
+	p, err := connector.GetObjectPermission(ctx, parts[0], parts[1], parts[2], parts[3], parts[4])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if p == nil {
+		log.Printf("[WARN] Object permission (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	diags := p.ToSchema(d)
+	if err := objectPermissionGrants(connector, p).read(ctx, d); err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+	return diags
+}
+
+func UpdateObjectPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.ObjectPermission).Parse(d)
+
+	if err := objectPermissionGrants(connector, p).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadObjectPermission(ctx, d, meta)
+}
+
+func DeleteObjectPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.ObjectPermission).Parse(d)
+
+	if err := objectPermissionGrants(connector, p).revokeDeclared(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := connector.RevokeObjectPermission(ctx, p)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportObjectPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadObjectPermission(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("object permission '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// objectPermissionGrants are the grants of the permission on the object to other principals.
+// They are revoked on the whole object, which revokes the column permissions too.
+func objectPermissionGrants(connector *mssql.Connector, p *model.ObjectPermission) unmanagedGrants {
+	return unmanagedGrants{
+		principal: p.Principal,
+		declared:  "principals",
+		list: func(ctx context.Context) ([]string, error) {
+			return connector.GetObjectPermissionGrantees(ctx, p.Database, p.Schema, p.Object, p.Permission, p.State)
+		},
+		grant: func(ctx context.Context, principal string) error {
+			other := *p
+			other.Principal = principal
+			return connector.SetObjectPermission(ctx, &other)
+		},
+		revoke: func(ctx context.Context, principal string) error {
+			other := *p
+			other.Principal, other.Columns, other.WithGrantOption = principal, nil, true
+			return connector.RevokeObjectPermission(ctx, &other)
+		},
+	}
+}
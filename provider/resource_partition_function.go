@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourcePartitionFunction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreatePartitionFunction,
+		ReadContext:   ReadPartitionFunction,
+		UpdateContext: UpdatePartitionFunction,
+		DeleteContext: DeletePartitionFunction,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportPartitionFunction,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the function, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"input_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringMatch(sqlType, "must be a SQL type, e.g. int or datetime2(0)"),
+				DiffSuppressFunc: sameSqlType,
+				Description:      "Type of the partitioning column, e.g. int or datetime2(0)",
+			},
+			"range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RIGHT",
+				ValidateFunc: validation.StringInSlice([]string{"LEFT", "RIGHT"}, false),
+				Description:  "Side of the partition each boundary value belongs to",
+			},
+			"boundaries": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Boundary values in ascending order, converted to the input type",
+			},
+			"next_used_filegroup": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filegroup set NEXT USED on the partition schemes of the function before splitting ranges",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+var sqlType = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*\s*(\(\s*\d+\s*(,\s*\d+\s*)?\))?$`)
+
+// sqlTypeDefaults are the types the server reports with their default length, precision or scale
+var sqlTypeDefaults = map[string]string{
+	"binary":         "binary(1)",
+	"char":           "char(1)",
+	"datetime2":      "datetime2(7)",
+	"datetimeoffset": "datetimeoffset(7)",
+	"decimal":        "decimal(18,0)",
+	"nchar":          "nchar(1)",
+	"numeric":        "numeric(18,0)",
+	"nvarchar":       "nvarchar(1)",
+	"time":           "time(7)",
+	"varbinary":      "varbinary(1)",
+	"varchar":        "varchar(1)",
+}
+
+// sameSqlType ignores the case, the spaces and the default length, precision or scale of SQL types
+func sameSqlType(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeSqlType(old) == normalizeSqlType(new)
+}
+
+func normalizeSqlType(sqlType string) string {
+	sqlType = strings.ToLower(strings.Join(strings.Fields(sqlType), ""))
+	if explicit, ok := sqlTypeDefaults[sqlType]; ok {
+		return explicit
+	}
+	return sqlType
+}
+
+func CreatePartitionFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	function := new(model.PartitionFunction).Parse(d)
+	if function.Database == "" {
+		function.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreatePartitionFunction(ctx, function); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", function.Database, function.Name))
+	return ReadPartitionFunction(ctx, d, meta)
+}
+
+func ReadPartitionFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid partition function ID '%s', expected database/name", d.Id())
+	}
+
+	function, err := connector.GetPartitionFunction(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if function == nil {
+		log.Printf("[WARN] Partition function (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// keep the boundary values as written when the server reads them back in another format
+	if state := new(model.PartitionFunction).Parse(d); len(state.Boundaries) == len(function.Boundaries) {
+		normalized, err := connector.NormalizePartitionBoundaries(ctx, function.Database, function.InputType, state.Boundaries)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if strings.Join(normalized, "\x00") == strings.Join(function.Boundaries, "\x00") {
+			function.Boundaries = state.Boundaries
+		}
+	}
+
+	return function.ToSchema(d)
+}
+
+func UpdatePartitionFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	function := new(model.PartitionFunction).Parse(d)
+
+	if d.HasChange("boundaries") {
+		old, _ := d.GetChange("boundaries")
+		oldBoundaries := make([]string, 0)
+		for _, value := range old.([]interface{}) {
+			oldBoundaries = append(oldBoundaries, value.(string))
+		}
+		if err := connector.AlterPartitionFunction(ctx, function, oldBoundaries); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadPartitionFunction(ctx, d, meta)
+}
+
+func DeletePartitionFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	function := new(model.PartitionFunction).Parse(d)
+
+	err := connector.DeletePartitionFunction(ctx, function.Database, function.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportPartitionFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadPartitionFunction(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("partition function '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourcePartitionScheme() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreatePartitionScheme,
+		ReadContext:   ReadPartitionScheme,
+		DeleteContext: DeletePartitionScheme,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportPartitionScheme,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the scheme, provider database by default",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Partition function of the scheme",
+			},
+			"filegroups": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"filegroups", "all_to"},
+				Description:  "Filegroups of the partitions in order, an extra one being the next used filegroup",
+			},
+			"all_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"filegroups", "all_to"},
+				Description:  "Filegroup of every partition",
+			},
+			"server": forceNewServerSchema(),
+		},
+	}
+}
+
+func CreatePartitionScheme(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	scheme := new(model.PartitionScheme).Parse(d)
+	if scheme.Database == "" {
+		scheme.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreatePartitionScheme(ctx, scheme); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", scheme.Database, scheme.Name))
+	return ReadPartitionScheme(ctx, d, meta)
+}
+
+func ReadPartitionScheme(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid partition scheme ID '%s', expected database/name", d.Id())
+	}
+
+	scheme, err := connector.GetPartitionScheme(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if scheme == nil {
+		log.Printf("[WARN] Partition scheme (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// splitting and merging ranges of the function adds and removes filegroups of the scheme, the filegroups
+	// of the state are the ones of the creation
+	state := new(model.PartitionScheme).Parse(d)
+	if state.AllTo != "" && allFilegroups(scheme.Filegroups, state.AllTo) {
+		scheme.AllTo = state.AllTo
+		scheme.Filegroups = make([]string, 0)
+	} else if len(state.Filegroups) > 0 {
+		scheme.Filegroups = state.Filegroups
+	}
+
+	return scheme.ToSchema(d)
+}
+
+// allFilegroups reports whether every filegroup is fg
+func allFilegroups(filegroups []string, fg string) bool {
+	for _, name := range filegroups {
+		if name != fg {
+			return false
+		}
+	}
+	return len(filegroups) > 0
+}
+
+func DeletePartitionScheme(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	scheme := new(model.PartitionScheme).Parse(d)
+
+	err := connector.DeletePartitionScheme(ctx, scheme.Database, scheme.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportPartitionScheme(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadPartitionScheme(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("partition scheme '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
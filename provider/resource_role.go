@@ -2,11 +2,11 @@ package provider
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/rbernardini/terraform-provider-mssql/model"
 	"github.com/rbernardini/terraform-provider-mssql/mssql"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,54 +16,219 @@ func ResourceRole() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: CreateRole,
 		ReadContext:   ReadRole,
+		UpdateContext: UpdateRole,
 		DeleteContext: DeleteRole,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportRole,
+		},
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    (&schema.Resource{Schema: roleSchema()}).CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeRoleStateV0,
 			},
 		},
+
+		Schema: roleSchema(),
 	}
 }
 
-func CreateRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
-	roleName := d.Get("name").(string)
-	stmtSQL := fmt.Sprintf("CREATE ROLE '%s'", roleName)
+// ResourceDatabaseRole is mssql_role under the name matching the mssql_database_roles data source.
+// It never had name IDs of version 0, so it needs no state upgrader.
+func ResourceDatabaseRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateRole,
+		ReadContext:   ReadRole,
+		UpdateContext: UpdateRole,
+		DeleteContext: DeleteRole,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportRole,
+		},
 
-	err := connector.ExecContext(ctx, stmtSQL)
-	if err == nil {
-		d.SetId(roleName)
+		Schema: roleSchema(),
 	}
-	return diag.FromErr(err)
 }
 
-func ReadRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+func roleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"database": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "In which database this role will be created, provider database by default",
+		},
+		"principal_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Principal ID of the role, used in resource ID so the role is tracked across renames",
+		},
+		"owner": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Database principal owning the role, the user creating it by default",
+		},
+		"members": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Names of the role members. When set, members not in the list are removed from the role, an empty list removing them all",
+		},
+		"server": serverSchema(),
+	}
+}
 
-	// SELECT name FROM [master].[sys].database_principals WHERE type = 'R'
+func CreateRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	role := new(model.DatabaseRole).Parse(d)
+	if role.Database == "" {
+		role.Database = defaultDatabase(connector)
+	}
 
-	stmtSQL := fmt.Sprintf("SHOW GRANTS FOR '%s'", d.Id())
+	err := connector.CreateDatabaseRole(ctx, role)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	err := connector.QueryContext(ctx, stmtSQL, func(rows *sql.Rows) error {
-		return nil
-	})
+	d.SetId(fmt.Sprintf("%s/%s", role.Database, role.Name))
+	for _, member := range role.Members {
+		if err := connector.AddDatabaseRoleMember(ctx, role.Database, role.Name, member); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadRole(ctx, d, meta)
+}
+
+func ReadRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	role, err := getRoleById(ctx, connector, d.Id())
 	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if role == nil {
 		log.Printf("[WARN] Role (%s) not found; removing from state", d.Id())
 		d.SetId("")
-		return diag.FromErr(err)
+		return nil
 	}
 
-	return diag.FromErr(d.Set("name", d.Id()))
+	// the members of roles not managing them stay out of state, so they are left alone, e.g. to
+	// mssql_database_role_member resources
+	role.Members = make([]string, 0)
+	if d.Get("members").(*schema.Set).Len() > 0 {
+		role.Members, err = connector.GetDatabaseRoleMembers(ctx, role.Database, role.PrincipalID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", role.Database, role.PrincipalID))
+	return role.ToSchema(d)
+}
+
+func UpdateRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	role := new(model.DatabaseRole).Parse(d)
+
+	if d.HasChange("name") {
+		oldName, _ := d.GetChange("name")
+		if err := connector.RenameDatabaseRole(ctx, role.Database, oldName.(string), role.Name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("owner") && role.Owner != "" {
+		if err := connector.AlterDatabaseRoleOwner(ctx, role.Database, role.Name, role.Owner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// removing members from the configuration stops managing them, an empty list removes them all
+	if d.HasChange("members") && configIsSet(d, "members") {
+		oldMembers, newMembers := d.GetChange("members")
+		for _, member := range oldMembers.(*schema.Set).Difference(newMembers.(*schema.Set)).List() {
+			if err := connector.DropDatabaseRoleMember(ctx, role.Database, role.Name, member.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, member := range newMembers.(*schema.Set).Difference(oldMembers.(*schema.Set)).List() {
+			if err := connector.AddDatabaseRoleMember(ctx, role.Database, role.Name, member.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadRole(ctx, d, meta)
 }
 
 func DeleteRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
-	stmtSQL := fmt.Sprintf("DROP ROLE '%s'", d.Get("name").(string))
-	log.Printf("[DEBUG] SQL: %s", stmtSQL)
-	err := connector.ExecContext(ctx, stmtSQL)
+	connector := resourceConnector(d, meta)
+	role := new(model.DatabaseRole).Parse(d)
 
+	err := connector.DeleteDatabaseRole(ctx, role)
+	if err == nil {
+		d.SetId("")
+	}
 	return diag.FromErr(err)
 }
+
+func ImportRole(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadRole(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("role '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getRoleById resolves database/principal_id ID, or database/name of imported resources.
+// ID without database, as used by version 0, refers to the provider database.
+func getRoleById(ctx context.Context, connector *mssql.Connector, id string) (*model.DatabaseRole, error) {
+	database, principalID, name, err := mssql.ParsePrincipalId(id)
+	if err != nil {
+		database, principalID, name = defaultDatabase(connector), 0, id
+	}
+
+	return connector.GetDatabaseRole(ctx, database, principalID, name)
+}
+
+// upgradeRoleStateV0 replaces the role name used as ID by version 0 with database/principal_id
+func upgradeRoleStateV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	connector, ok := meta.(*mssql.Connector)
+	id, _ := rawState["id"].(string)
+	if !ok || id == "" {
+		return rawState, nil
+	}
+
+	role, err := getRoleById(ctx, connector, id)
+	if err != nil || role == nil {
+		// Keep the name, Read falls back to lookup by name
+		log.Printf("[WARN] Role (%s) principal ID lookup failed during state upgrade: %v", id, err)
+		return rawState, nil
+	}
+
+	rawState["id"] = fmt.Sprintf("%s/%d", role.Database, role.PrincipalID)
+	rawState["database"] = role.Database
+	rawState["principal_id"] = role.PrincipalID
+	return rawState, nil
+}
+
+// defaultDatabase is the database the provider connects to when resource does not name one
+func defaultDatabase(connector *mssql.Connector) string {
+	if connector.Database == "" {
+		return "master"
+	}
+	return connector.Database
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRole_renameOutsideTerraform(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig_basic("tf_acc_role"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_role.test", "name", "tf_acc_role"),
+					testAccStoreAttr("mssql_role.test", "id", &id),
+				),
+			},
+			{
+				// Renamed role is still found by principal ID and renamed back in place, rather than recreated
+				PreConfig: testAccExec(t, "USE [master]; ALTER ROLE [tf_acc_role] WITH NAME = [tf_acc_role_renamed]"),
+				Config:    testAccRoleConfig_basic("tf_acc_role"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_role.test", "name", "tf_acc_role"),
+					resource.TestCheckResourceAttrPtr("mssql_role.test", "id", &id),
+				),
+			},
+			{
+				ResourceName:      "mssql_role.test",
+				ImportState:       true,
+				ImportStateId:     "master/tf_acc_role",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRoleConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "mssql_role" "test" {
+		database = "master"
+		name     = "%s"
+}`, name)
+}
+
+func TestAccDatabaseRole_members(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseRoleConfig_members(`[mssql_database_role.member.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_database_role.test", "owner", "dbo"),
+					resource.TestCheckResourceAttr("mssql_database_role.test", "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr("mssql_database_role.test", "members.*", "tf_acc_role_member"),
+				),
+			},
+			{
+				// Member added outside of Terraform is detected and removed
+				PreConfig: testAccExec(t, "USE [master]; CREATE ROLE [tf_acc_role_drift]; ALTER ROLE [tf_acc_role_owner] ADD MEMBER [tf_acc_role_drift]"),
+				Config:    testAccDatabaseRoleConfig_members(`[mssql_database_role.member.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_database_role.test", "members.#", "1"),
+				),
+			},
+			{
+				PreConfig: testAccExec(t, "USE [master]; DROP ROLE [tf_acc_role_drift]"),
+				Config:    testAccDatabaseRoleConfig_members(`[mssql_database_role.member.name]`),
+				PlanOnly:  true,
+			},
+			{
+				// An empty list removes all the members
+				Config: testAccDatabaseRoleConfig_members(`[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_database_role.test", "members.#", "0"),
+					testAccCheckExec(t, `USE [master]; IF EXISTS (SELECT 1 FROM [sys].[database_role_members]
+						WHERE role_principal_id = DATABASE_PRINCIPAL_ID('tf_acc_role_owner'))
+						THROW 50000, 'members left in the role', 1`),
+				),
+			},
+			{
+				// Without members, the membership is not managed
+				PreConfig: testAccExec(t, "USE [master]; ALTER ROLE [tf_acc_role_owner] ADD MEMBER [tf_acc_role_member]"),
+				Config:    testAccDatabaseRoleConfig_members(""),
+				PlanOnly:  true,
+			},
+		},
+	})
+}
+
+// testAccDatabaseRoleConfig_members leaves members out when empty
+func testAccDatabaseRoleConfig_members(members string) string {
+	if members != "" {
+		members = "members  = " + members
+	}
+	return fmt.Sprintf(`
+resource "mssql_database_role" "member" {
+		database = "master"
+		name     = "tf_acc_role_member"
+}
+
+resource "mssql_database_role" "test" {
+		database = "master"
+		name     = "tf_acc_role_owner"
+		%s
+}`, members)
+}
+
+func TestAccDatabaseRoleMember_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseRoleMemberConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_database_role_member.test", "id", "master/tf_acc_role_shared/tf_acc_role_member"),
+				),
+			},
+			{
+				ResourceName:      "mssql_database_role_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDatabaseRoleMemberConfig_basic() string {
+	return `
+resource "mssql_database_role" "shared" {
+		database = "master"
+		name     = "tf_acc_role_shared"
+}
+
+resource "mssql_database_role" "member" {
+		database = "master"
+		name     = "tf_acc_role_member"
+}
+
+resource "mssql_database_role_member" "test" {
+		database = "master"
+		role     = mssql_database_role.shared.name
+		member   = mssql_database_role.member.name
+}`
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSchema,
+		ReadContext:   ReadSchema,
+		UpdateContext: UpdateSchema,
+		DeleteContext: DeleteSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportSchema,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    (&schema.Resource{Schema: databaseSchemaSchema()}).CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeSchemaStateV0,
+			},
+		},
+
+		Schema: databaseSchemaSchema(),
+	}
+}
+
+func databaseSchemaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"database": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Database of the schema, provider database by default",
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"owner": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Database principal owning the schema, the user creating it by default",
+		},
+		"schema_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"cascade_on_destroy": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Drop the objects of the schema before dropping it, instead of failing when it is not empty",
+		},
+		"server": serverSchema(),
+	}
+}
+
+func CreateSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	s := new(model.DatabaseSchema).Parse(d)
+	if s.Database == "" {
+		s.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateDatabaseSchema(ctx, s); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", s.Database, s.Name))
+	return ReadSchema(ctx, d, meta)
+}
+
+func ReadSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	s, err := getSchemaById(ctx, connector, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if s == nil {
+		log.Printf("[WARN] Schema (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", s.Database, s.SchemaID))
+	return s.ToSchema(d)
+}
+
+// getSchemaById resolves database/schema_id ID, or database/name of legacy and imported resources
+func getSchemaById(ctx context.Context, connector *mssql.Connector, id string) (*model.DatabaseSchema, error) {
+	database, schemaID, name, err := mssql.ParsePrincipalId(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema ID '%s', expected database/schema_id or database/name", id)
+	}
+
+	if schemaID != 0 {
+		return connector.GetDatabaseSchemaByID(ctx, database, schemaID)
+	}
+	return connector.GetDatabaseSchema(ctx, database, name)
+}
+
+func UpdateSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	s := new(model.DatabaseSchema).Parse(d)
+
+	if d.HasChange("owner") && s.Owner != "" {
+		if err := connector.AlterDatabaseSchemaOwner(ctx, s.Database, s.Name, s.Owner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadSchema(ctx, d, meta)
+}
+
+func DeleteSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	s := new(model.DatabaseSchema).Parse(d)
+
+	err := connector.DeleteDatabaseSchema(ctx, s.Database, s.Name, d.Get("cascade_on_destroy").(bool))
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	connector := resourceConnector(d, meta)
+	s, err := getSchemaById(ctx, connector, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if s == nil {
+		// Numeric schema names are valid too
+		database, name, _ := mssql.ParseUserId(d.Id())
+		s, err = connector.GetDatabaseSchema(ctx, database, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s == nil {
+		return nil, fmt.Errorf("schema '%s' not found", d.Id())
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", s.Database, s.SchemaID))
+	if diags := s.ToSchema(d); diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// upgradeSchemaStateV0 replaces database/name ID used by version 0 with database/schema_id
+func upgradeSchemaStateV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	connector, ok := meta.(*mssql.Connector)
+	id, _ := rawState["id"].(string)
+	if !ok || id == "" {
+		return rawState, nil
+	}
+
+	database, name, err := mssql.ParseUserId(id)
+	if err != nil {
+		return rawState, nil
+	}
+	s, err := connector.GetDatabaseSchema(ctx, database, name)
+	if err != nil || s == nil {
+		// Keep the name, Read falls back to lookup by name
+		log.Printf("[WARN] Schema (%s) ID lookup failed during state upgrade: %v", id, err)
+		return rawState, nil
+	}
+
+	rawState["id"] = fmt.Sprintf("%s/%d", s.Database, s.SchemaID)
+	rawState["schema_id"] = s.SchemaID
+	return rawState, nil
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceSchemaPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSchemaPermission,
+		ReadContext:   ReadSchemaPermission,
+		UpdateContext: UpdateSchemaPermission,
+		DeleteContext: DeleteSchemaPermission,
+		CustomizeDiff: authoritativeDiff("principals"),
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportSchemaPermission,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the schema, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the user or role the permission is granted or denied to",
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(permissionName, "must be an upper case permission name, e.g. SELECT"),
+				Description:  "Permission on the schema, e.g. SELECT, EXECUTE or ALTER",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.PermissionStateGrant,
+				ValidateFunc: validation.StringInSlice([]string{model.PermissionStateGrant, model.PermissionStateDeny}, false),
+			},
+			"with_grant_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Allow the principal to grant the permission to other principals, GRANT state only",
+			},
+			"authoritative": authoritativeSchema(),
+			"principals":    declaredSchema("Other principals this authoritative resource grants or denies the permission to, with the same options"),
+			"unmanaged":     unmanagedSchema(),
+			"server":        forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateSchemaPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.SchemaPermission).Parse(d)
+	if p.Database == "" {
+		p.Database = defaultDatabase(connector)
+	}
+	if p.WithGrantOption && p.State != model.PermissionStateGrant {
+		return diag.Errorf("with_grant_option requires state %s", model.PermissionStateGrant)
+	}
+
+	if err := connector.SetSchemaPermission(ctx, p); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := schemaPermissionGrants(connector, p).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", p.Database, p.Schema, p.Principal, p.Permission))
+	return ReadSchemaPermission(ctx, d, meta)
+}
+
+func ReadSchemaPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 4)
+	if len(parts) != 4 {
+		return diag.Errorf("invalid permission ID '%s', expected database/schema/principal/permission", d.Id())
+	}
+
+	p, err := connector.GetSchemaPermission(ctx, parts[0], parts[1], parts[2], parts[3])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if p == nil {
+		log.Printf("[WARN] Schema permission (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	diags := p.ToSchema(d)
+	if err := schemaPermissionGrants(connector, p).read(ctx, d); err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+	return diags
+}
+
+func UpdateSchemaPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.SchemaPermission).Parse(d)
+
+	if err := schemaPermissionGrants(connector, p).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadSchemaPermission(ctx, d, meta)
+}
+
+func DeleteSchemaPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.SchemaPermission).Parse(d)
+
+	if err := schemaPermissionGrants(connector, p).revokeDeclared(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := connector.RevokeSchemaPermission(ctx, p)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportSchemaPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadSchemaPermission(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("schema permission '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// schemaPermissionGrants are the grants of the permission on the schema to other principals
+func schemaPermissionGrants(connector *mssql.Connector, p *model.SchemaPermission) unmanagedGrants {
+	return unmanagedGrants{
+		principal: p.Principal,
+		declared:  "principals",
+		list: func(ctx context.Context) ([]string, error) {
+			return connector.GetSchemaPermissionGrantees(ctx, p.Database, p.Schema, p.Permission, p.State)
+		},
+		grant: func(ctx context.Context, principal string) error {
+			other := *p
+			other.Principal = principal
+			return connector.SetSchemaPermission(ctx, &other)
+		},
+		revoke: func(ctx context.Context, principal string) error {
+			other := *p
+			other.Principal, other.WithGrantOption = principal, true
+			return connector.RevokeSchemaPermission(ctx, &other)
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSchemaPermission_revokeOutsideTerraform(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaPermissionConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_schema_permission.test", "id", "master/dbo/tf_acc_schema_readers/SELECT"),
+					resource.TestCheckResourceAttr("mssql_schema_permission.test", "state", "GRANT"),
+				),
+			},
+			{
+				// Revoked permission is planned to be granted again
+				PreConfig:          testAccExec(t, "USE [master]; REVOKE SELECT ON SCHEMA::[dbo] FROM [tf_acc_schema_readers]"),
+				Config:             testAccSchemaPermissionConfig_basic,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccSchemaPermissionConfig_basic,
+			},
+			{
+				ResourceName:      "mssql_schema_permission.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchemaPermission_authoritativeKeepsDeny(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaPermissionConfig_authoritativeDeny,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_schema_permission.grant", "unmanaged.#", "0"),
+					resource.TestCheckResourceAttr("mssql_schema_permission.deny", "state", "DENY"),
+				),
+			},
+			{
+				// The DENY of another principal is not revoked by the authoritative GRANT
+				Config:   testAccSchemaPermissionConfig_authoritativeDeny,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccSchemaPermissionConfig_authoritativeDeny = `
+resource "mssql_database_role" "readers" {
+		database = "master"
+		name     = "tf_acc_schema_auth_readers"
+}
+
+resource "mssql_database_role" "denied" {
+		database = "master"
+		name     = "tf_acc_schema_auth_denied"
+}
+
+resource "mssql_schema_permission" "deny" {
+		database   = "master"
+		schema     = "dbo"
+		principal  = mssql_database_role.denied.name
+		permission = "SELECT"
+		state      = "DENY"
+}
+
+resource "mssql_schema_permission" "grant" {
+		database      = "master"
+		schema        = "dbo"
+		principal     = mssql_database_role.readers.name
+		permission    = "SELECT"
+		authoritative = true
+		depends_on    = [mssql_schema_permission.deny]
+}`
+
+const testAccSchemaPermissionConfig_basic = `
+resource "mssql_database_role" "readers" {
+		database = "master"
+		name     = "tf_acc_schema_readers"
+}
+
+resource "mssql_schema_permission" "test" {
+		database   = "master"
+		schema     = "dbo"
+		principal  = mssql_database_role.readers.name
+		permission = "SELECT"
+}`
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSchema_cascadeOnDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("mssql_schema.test", "id", regexp.MustCompile(`^master/\d+$`)),
+					resource.TestCheckResourceAttr("mssql_schema.test", "owner", "tf_acc_schema_owner"),
+				),
+			},
+			{
+				// Owner changed outside Terraform is planned to be changed back
+				PreConfig:          testAccExec(t, "USE [master]; ALTER AUTHORIZATION ON SCHEMA::[tf_acc_schema] TO [dbo]"),
+				Config:             testAccSchemaConfig_basic,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Objects created outside Terraform are dropped with the schema
+				PreConfig: testAccExec(t, "USE [master]; CREATE TABLE [tf_acc_schema].[t] (id int PRIMARY KEY)"),
+				Config:    testAccSchemaConfig_basic,
+			},
+			{
+				ResourceName:            "mssql_schema.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cascade_on_destroy"},
+			},
+		},
+	})
+}
+
+const testAccSchemaConfig_basic = `
+resource "mssql_database_role" "owner" {
+		database = "master"
+		name     = "tf_acc_schema_owner"
+}
+
+resource "mssql_schema" "test" {
+		database           = "master"
+		name               = "tf_acc_schema"
+		owner              = mssql_database_role.owner.name
+		cascade_on_destroy = true
+}`
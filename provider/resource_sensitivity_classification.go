@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceSensitivityClassification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSensitivityClassification,
+		ReadContext:   ReadSensitivityClassification,
+		UpdateContext: UpdateSensitivityClassification,
+		DeleteContext: DeleteSensitivityClassification,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportSensitivityClassification,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the table, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"table": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"column": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"label", "information_type"},
+				Description:  "Sensitivity label, e.g. Confidential - GDPR",
+			},
+			"label_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: sameUUID,
+				Description:      "ID of the label, e.g. of the Microsoft Purview Information Protection label",
+			},
+			"information_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"label", "information_type"},
+				Description:  "Type of the information, e.g. Contact Info or Financial",
+			},
+			"information_type_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsUUID,
+				DiffSuppressFunc: sameUUID,
+				Description:      "ID of the information type",
+			},
+			"rank": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "LOW", "MEDIUM", "HIGH", "CRITICAL"}, false),
+				Description:  "Sensitivity rank, e.g. HIGH",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func sameUUID(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+func CreateSensitivityClassification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	classification := new(model.SensitivityClassification).Parse(d)
+	if classification.Database == "" {
+		classification.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.SetSensitivityClassification(ctx, classification); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", classification.Database, classification.Schema, classification.Table, classification.Column))
+	return ReadSensitivityClassification(ctx, d, meta)
+}
+
+func ReadSensitivityClassification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 4)
+	if len(parts) != 4 {
+		return diag.Errorf("invalid sensitivity classification ID '%s', expected database/schema/table/column", d.Id())
+	}
+
+	classification, err := connector.GetSensitivityClassification(ctx, parts[0], parts[1], parts[2], parts[3])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if classification == nil {
+		log.Printf("[WARN] Sensitivity classification (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return classification.ToSchema(d)
+}
+
+// UpdateSensitivityClassification adds the classification again, which replaces the current one
+func UpdateSensitivityClassification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	classification := new(model.SensitivityClassification).Parse(d)
+
+	if err := connector.SetSensitivityClassification(ctx, classification); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadSensitivityClassification(ctx, d, meta)
+}
+
+func DeleteSensitivityClassification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	classification := new(model.SensitivityClassification).Parse(d)
+
+	err := connector.DropSensitivityClassification(ctx, classification)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportSensitivityClassification(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadSensitivityClassification(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("sensitivity classification '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
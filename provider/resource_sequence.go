@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceSequence() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSequence,
+		ReadContext:   ReadSequence,
+		UpdateContext: UpdateSequence,
+		DeleteContext: DeleteSequence,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportSequence,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the sequence, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "bigint",
+				ValidateFunc: validation.StringInSlice([]string{"tinyint", "smallint", "int", "bigint"}, false),
+			},
+			"start": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "First value of the sequence, the sequence is restarted with it when changed",
+			},
+			"increment": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntNotInSlice([]int{0}),
+			},
+			"min_value": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum value of the sequence, the minimum of the data type by default",
+			},
+			"max_value": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum value of the sequence, the maximum of the data type by default",
+			},
+			"cycle": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restart from the minimum, or maximum for descending sequences, once the other bound is exceeded",
+			},
+			"cached": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"cache_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of values cached when cached is set, server default if 0",
+			},
+			"current_value": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Last value returned by the sequence",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	sequence := new(model.Sequence).Parse(d)
+	if sequence.Database == "" {
+		sequence.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateSequence(ctx, sequence); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", sequence.Database, sequence.Schema, sequence.Name))
+	return ReadSequence(ctx, d, meta)
+}
+
+func ReadSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid sequence ID '%s', expected database/schema/name", d.Id())
+	}
+
+	sequence, err := connector.GetSequence(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if sequence == nil {
+		log.Printf("[WARN] Sequence (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return sequence.ToSchema(d)
+}
+
+func UpdateSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	sequence := new(model.Sequence).Parse(d)
+
+	if err := connector.AlterSequence(ctx, sequence, d.HasChange("start")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadSequence(ctx, d, meta)
+}
+
+func DeleteSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	sequence := new(model.Sequence).Parse(d)
+
+	err := connector.DeleteSequence(ctx, sequence.Database, sequence.Schema, sequence.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportSequence(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadSequence(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("sequence '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceServerAudit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateServerAudit,
+		ReadContext:   ReadServerAudit,
+		UpdateContext: UpdateServerAudit,
+		DeleteContext: DeleteServerAudit,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportServerAudit,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{model.AuditTargetFile, model.AuditTargetApplicationLog,
+					model.AuditTargetSecurityLog, model.AuditTargetURL}, false),
+				Description: "Where the audit writes, FILE, APPLICATION_LOG, SECURITY_LOG or URL of Azure Blob Storage",
+			},
+			"path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: samePathIgnoringTrailingSeparator,
+				Description:      "Directory of the audit files for FILE target, container URL for URL target",
+			},
+			"max_size_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum size of an audit file in MB, unlimited if 0",
+			},
+			"max_rollover_files": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of audit files kept, unlimited if 0",
+			},
+			"reserve_disk_space": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the maximum size is allocated upfront",
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Days the audit blobs of URL target are kept, forever if 0. Not read back from the server",
+			},
+			"queue_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntAtLeast(1000)),
+				Description:  "Milliseconds the audit events may be queued, 0 to write synchronously",
+			},
+			"on_failure": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "CONTINUE",
+				ValidateFunc: validation.StringInSlice([]string{"CONTINUE", "SHUTDOWN", "FAIL_OPERATION"}, false),
+				Description:  "What the server does when the audit cannot write, CONTINUE, SHUTDOWN or FAIL_OPERATION",
+			},
+			"predicate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Condition of the WHERE clause filtering the audited events",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"audit_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// samePathIgnoringTrailingSeparator ignores the trailing separator the server appends to the file path
+func samePathIgnoringTrailingSeparator(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimRight(old, `\/`) == strings.TrimRight(new, `\/`)
+}
+
+func CreateServerAudit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	audit := new(model.ServerAudit).Parse(d)
+	if (audit.Target == model.AuditTargetFile || audit.Target == model.AuditTargetURL) && audit.Path == "" {
+		return diag.Errorf("path is required for %s target", audit.Target)
+	}
+
+	if err := connector.CreateServerAudit(ctx, audit); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(audit.Name)
+	return ReadServerAudit(ctx, d, meta)
+}
+
+func ReadServerAudit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	audit, err := connector.GetServerAudit(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if audit == nil {
+		log.Printf("[WARN] Server audit (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return audit.ToSchema(d)
+}
+
+func UpdateServerAudit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	audit := new(model.ServerAudit).Parse(d)
+	if (audit.Target == model.AuditTargetFile || audit.Target == model.AuditTargetURL) && audit.Path == "" {
+		return diag.Errorf("path is required for %s target", audit.Target)
+	}
+
+	var err error
+	if d.HasChangeExcept("enabled") {
+		err = connector.AlterServerAudit(ctx, audit)
+	} else {
+		err = connector.SetServerAuditState(ctx, audit.Name, audit.Enabled)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadServerAudit(ctx, d, meta)
+}
+
+func DeleteServerAudit(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	err := connector.DeleteServerAudit(ctx, d.Id())
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportServerAudit(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadServerAudit(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("server audit '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
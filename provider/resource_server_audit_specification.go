@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceServerAuditSpecification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateServerAuditSpecification,
+		ReadContext:   ReadServerAuditSpecification,
+		UpdateContext: UpdateServerAuditSpecification,
+		DeleteContext: DeleteServerAuditSpecification,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportServerAuditSpecification,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"audit": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Server audit the specification writes to",
+			},
+			"action_groups": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Audited action groups, e.g. LOGIN_CHANGE_GROUP or FAILED_LOGIN_GROUP",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateServerAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	spec := new(model.ServerAuditSpecification).Parse(d)
+
+	if err := connector.CreateServerAuditSpecification(ctx, spec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(spec.Name)
+	return ReadServerAuditSpecification(ctx, d, meta)
+}
+
+func ReadServerAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	spec, err := connector.GetServerAuditSpecification(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if spec == nil {
+		log.Printf("[WARN] Server audit specification (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return spec.ToSchema(d)
+}
+
+func UpdateServerAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	spec := new(model.ServerAuditSpecification).Parse(d)
+
+	old, _ := d.GetChange("action_groups")
+	oldGroups := make([]string, 0)
+	for _, group := range old.(*schema.Set).List() {
+		oldGroups = append(oldGroups, group.(string))
+	}
+
+	if err := connector.AlterServerAuditSpecification(ctx, oldGroups, spec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadServerAuditSpecification(ctx, d, meta)
+}
+
+func DeleteServerAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	err := connector.DeleteServerAuditSpecification(ctx, d.Id())
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportServerAuditSpecification(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadServerAuditSpecification(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("server audit specification '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceServerPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateServerPermission,
+		ReadContext:   ReadServerPermission,
+		UpdateContext: UpdateServerPermission,
+		DeleteContext: DeleteServerPermission,
+		CustomizeDiff: authoritativeDiff("principals"),
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportServerPermission,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"principal": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the login or server role the permission is granted or denied to",
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(permissionName, "must be an upper case permission name, e.g. VIEW SERVER STATE"),
+				Description:  "Server scope permission, e.g. VIEW SERVER STATE, ALTER ANY LOGIN or CONNECT SQL",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.PermissionStateGrant,
+				ValidateFunc: validation.StringInSlice([]string{model.PermissionStateGrant, model.PermissionStateDeny}, false),
+			},
+			"with_grant_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Allow the principal to grant the permission to other principals, GRANT state only",
+			},
+			"authoritative": authoritativeSchema(),
+			"principals":    declaredSchema("Other logins and server roles this authoritative resource grants or denies the permission to, with the same options"),
+			"unmanaged":     unmanagedSchema(),
+			"server":        forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateServerPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.ServerPermissionGrant).Parse(d)
+	if p.WithGrantOption && p.State != model.PermissionStateGrant {
+		return diag.Errorf("with_grant_option requires state %s", model.PermissionStateGrant)
+	}
+
+	if err := connector.SetServerPermission(ctx, p); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := serverPermissionGrants(connector, p).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", p.Principal, p.Permission))
+	return ReadServerPermission(ctx, d, meta)
+}
+
+func ReadServerPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	// the permission is last, as Windows and Azure AD principal names may contain slashes, permissions never do
+	i := strings.LastIndex(d.Id(), "/")
+	if i <= 0 {
+		return diag.Errorf("invalid permission ID '%s', expected principal/permission", d.Id())
+	}
+
+	p, err := connector.GetServerPermission(ctx, d.Id()[:i], d.Id()[i+1:])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if p == nil {
+		log.Printf("[WARN] Server permission (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	diags := p.ToSchema(d)
+	if err := serverPermissionGrants(connector, p).read(ctx, d); err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+	return diags
+}
+
+func UpdateServerPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.ServerPermissionGrant).Parse(d)
+
+	if err := serverPermissionGrants(connector, p).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadServerPermission(ctx, d, meta)
+}
+
+func DeleteServerPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	p := new(model.ServerPermissionGrant).Parse(d)
+
+	if err := serverPermissionGrants(connector, p).revokeDeclared(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := connector.RevokeServerPermission(ctx, p)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportServerPermission(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadServerPermission(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("server permission '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// serverPermissionGrants are the grants of the server permission to other logins and server roles
+func serverPermissionGrants(connector *mssql.Connector, p *model.ServerPermissionGrant) unmanagedGrants {
+	return unmanagedGrants{
+		principal: p.Principal,
+		declared:  "principals",
+		list: func(ctx context.Context) ([]string, error) {
+			return connector.GetServerPermissionGrantees(ctx, p.Permission, p.State)
+		},
+		grant: func(ctx context.Context, principal string) error {
+			other := *p
+			other.Principal = principal
+			return connector.SetServerPermission(ctx, &other)
+		},
+		revoke: func(ctx context.Context, principal string) error {
+			other := *p
+			other.Principal, other.WithGrantOption = principal, true
+			return connector.RevokeServerPermission(ctx, &other)
+		},
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceServerRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateServerRole,
+		ReadContext:   ReadServerRole,
+		UpdateContext: UpdateServerRole,
+		DeleteContext: DeleteServerRole,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportServerRole,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Login or server role owning the role, the login creating it by default",
+			},
+			"sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SID of the role, used as resource ID so the role is tracked across renames",
+			},
+			"principal_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateServerRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	role := new(model.ServerRole).Parse(d)
+
+	if err := connector.CreateServerRole(ctx, role); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(role.Name)
+	return ReadServerRole(ctx, d, meta)
+}
+
+func ReadServerRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	// Imported IDs and the ID set on creation hold the role name
+	sid, name := d.Id(), ""
+	if !mssql.IsSid(sid) {
+		sid, name = "", d.Id()
+	}
+	role, err := connector.GetServerRole(ctx, sid, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if role == nil {
+		log.Printf("[WARN] Server role (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(role.Sid)
+	return role.ToSchema(d)
+}
+
+func UpdateServerRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	role := new(model.ServerRole).Parse(d)
+
+	if d.HasChange("name") {
+		oldName, _ := d.GetChange("name")
+		if err := connector.RenameServerRole(ctx, oldName.(string), role.Name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("owner") && role.Owner != "" {
+		if err := connector.AlterServerRoleOwner(ctx, role.Name, role.Owner); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadServerRole(ctx, d, meta)
+}
+
+func DeleteServerRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	err := connector.DeleteServerRole(ctx, d.Get("name").(string))
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportServerRole(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadServerRole(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("server role '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceServerRoleMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateServerRoleMember,
+		ReadContext:   ReadServerRoleMember,
+		UpdateContext: UpdateServerRoleMember,
+		DeleteContext: DeleteServerRoleMember,
+		CustomizeDiff: authoritativeDiff("members"),
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportServerRoleMember,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the server role, custom or fixed like sysadmin or dbcreator",
+			},
+			"member": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the login or server role added to the role",
+			},
+			"authoritative": authoritativeSchema(),
+			"members":       declaredSchema("Other members this authoritative resource adds to the server role"),
+			"unmanaged":     unmanagedSchema(),
+			"server":        forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateServerRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rm := new(model.ServerRoleMember).Parse(d)
+
+	if err := connector.AddServerRoleMember(ctx, rm.Role, rm.Member); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := serverRoleMembers(connector, rm).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", rm.Role, rm.Member))
+	return ReadServerRoleMember(ctx, d, meta)
+}
+
+func ReadServerRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rm, err := parseServerRoleMemberId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isMember, err := connector.IsServerRoleMember(ctx, rm.Role, rm.Member)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !isMember {
+		log.Printf("[WARN] Server role membership (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	diags := rm.ToSchema(d)
+	if err := serverRoleMembers(connector, rm).read(ctx, d); err != nil {
+		diags = append(diags, diag.FromErr(err)[0])
+	}
+	return diags
+}
+
+func UpdateServerRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rm := new(model.ServerRoleMember).Parse(d)
+
+	if err := serverRoleMembers(connector, rm).apply(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadServerRoleMember(ctx, d, meta)
+}
+
+func DeleteServerRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	rm := new(model.ServerRoleMember).Parse(d)
+
+	if err := serverRoleMembers(connector, rm).revokeDeclared(ctx, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	isMember, err := connector.IsServerRoleMember(ctx, rm.Role, rm.Member)
+	if err == nil && isMember {
+		err = connector.DropServerRoleMember(ctx, rm.Role, rm.Member)
+	}
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportServerRoleMember(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadServerRoleMember(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("server role membership '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseServerRoleMemberId splits role/member ID. Windows login members contain a backslash, but no slash.
+func parseServerRoleMemberId(id string) (*model.ServerRoleMember, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid server role membership ID '%s', expected role/member", id)
+	}
+	return &model.ServerRoleMember{Role: parts[0], Member: parts[1]}, nil
+}
+
+// serverRoleMembers are the declared and other members of the server role
+func serverRoleMembers(connector *mssql.Connector, rm *model.ServerRoleMember) unmanagedGrants {
+	return unmanagedGrants{
+		principal: rm.Member,
+		declared:  "members",
+		list: func(ctx context.Context) ([]string, error) {
+			return connector.GetServerRoleMembers(ctx, rm.Role)
+		},
+		grant: func(ctx context.Context, member string) error {
+			return connector.AddServerRoleMember(ctx, rm.Role, member)
+		},
+		revoke: func(ctx context.Context, member string) error {
+			return connector.DropServerRoleMember(ctx, rm.Role, member)
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccServerRole_member(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerRoleConfig_member("tf_acc_server_role"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mssql_server_role.test", "sid"),
+					resource.TestCheckResourceAttr("mssql_server_role_member.test", "id", "dbcreator/tf_acc_server_role"),
+				),
+			},
+			{
+				ResourceName:      "mssql_server_role.test",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_server_role",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "mssql_server_role_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServerRoleConfig_member(name string) string {
+	return fmt.Sprintf(`
+resource "mssql_server_role" "test" {
+		name = "%s"
+}
+
+resource "mssql_server_role_member" "test" {
+		role   = "dbcreator"
+		member = mssql_server_role.test.name
+}`, name)
+}
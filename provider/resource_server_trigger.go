@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceServerTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateServerTrigger,
+		ReadContext:   ReadServerTrigger,
+		UpdateContext: UpdateServerTrigger,
+		DeleteContext: DeleteServerTrigger,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportServerTrigger,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(ddlEvent, "must be an uppercase DDL event type or group"),
+				},
+				Description: "DDL events or event groups firing the trigger, e.g. CREATE_LOGIN or DDL_DATABASE_EVENTS",
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "T-SQL statements of the trigger, following AS",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"definition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CREATE TRIGGER statement read back from the server",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+var ddlEvent = regexp.MustCompile(`^[A-Z][A-Z_]*$`)
+
+// sameModuleBody ignores the leading and trailing whitespace the body of triggers, views and other modules is stored without
+func sameModuleBody(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func CreateServerTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger := new(model.ServerTrigger).Parse(d)
+
+	if err := connector.CreateServerTrigger(ctx, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(trigger.Name)
+	return ReadServerTrigger(ctx, d, meta)
+}
+
+func ReadServerTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger, err := connector.GetServerTrigger(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if trigger == nil {
+		log.Printf("[WARN] Server trigger (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if trigger.Body == "" {
+		// encrypted, or the definition could not be parsed
+		trigger.Body = d.Get("body").(string)
+	}
+
+	return trigger.ToSchema(d)
+}
+
+func UpdateServerTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger := new(model.ServerTrigger).Parse(d)
+
+	var err error
+	if d.HasChanges("events", "body") {
+		err = connector.AlterServerTrigger(ctx, trigger)
+	} else {
+		err = connector.SetServerTriggerEnabled(ctx, trigger.Name, trigger.Enabled)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadServerTrigger(ctx, d, meta)
+}
+
+func DeleteServerTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+
+	err := connector.DeleteServerTrigger(ctx, d.Id())
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportServerTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadServerTrigger(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("server trigger '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
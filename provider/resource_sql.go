@@ -2,11 +2,8 @@ package provider
 
 import (
 	"context"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/rbernardini/terraform-provider-mssql/mssql"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -32,17 +29,16 @@ func ResourceSql() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"server": forceNewServerSchema(),
 		},
 	}
 }
 
 func CreateSql(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(d, meta)
 	name := d.Get("name").(string)
 	createSql := d.Get("create_sql").(string)
 
-	log.Println("Executing SQL", createSql)
-
 	err := connector.ExecContext(ctx, createSql)
 
 	if err == nil {
@@ -57,11 +53,9 @@ func ReadSql(ctx context.Context, d *schema.ResourceData, meta interface{}) diag
 }
 
 func DeleteSql(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(d, meta)
 	deleteSql := d.Get("delete_sql").(string)
 
-	log.Println("Executing SQL:", deleteSql)
-
 	err := connector.ExecContext(ctx, deleteSql)
 
 	if err == nil {
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceStoredProcedure() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateStoredProcedure,
+		ReadContext:   ReadStoredProcedure,
+		UpdateContext: UpdateStoredProcedure,
+		DeleteContext: DeleteStoredProcedure,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStoredProcedure,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the procedure, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "Declarations of the parameters, e.g. @days int = 30, @dry_run bit = 0",
+			},
+			"execute_as": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: sameExecuteAs,
+				Description:      "Security context of the procedure, CALLER, SELF, OWNER or a user name",
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "T-SQL statements of the procedure, following AS",
+			},
+			"definition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CREATE PROCEDURE statement read back from the database",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+// sameExecuteAs ignores the case of the CALLER, SELF and OWNER keywords, CALLER being the default
+func sameExecuteAs(_, old, new string, _ *schema.ResourceData) bool {
+	normalize := func(principal string) string {
+		switch strings.ToUpper(principal) {
+		case "CALLER":
+			return ""
+		case "SELF", "OWNER":
+			return strings.ToUpper(principal)
+		}
+		return principal
+	}
+	return normalize(old) == normalize(new)
+}
+
+func CreateStoredProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	procedure := new(model.StoredProcedure).Parse(d)
+	if procedure.Database == "" {
+		procedure.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateOrAlterStoredProcedure(ctx, procedure); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", procedure.Database, procedure.Schema, procedure.Name))
+	return ReadStoredProcedure(ctx, d, meta)
+}
+
+func ReadStoredProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid stored procedure ID '%s', expected database/schema/name", d.Id())
+	}
+
+	procedure, err := connector.GetStoredProcedure(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if procedure == nil {
+		log.Printf("[WARN] Stored procedure (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// keep the parameters and body as written while they create the definition read back, encrypted definitions
+	// included, so only the changes made outside of Terraform are planned
+	state := new(model.StoredProcedure).Parse(d)
+	state.Schema, state.Name = procedure.Schema, procedure.Name
+	if procedure.Definition == "" || mssql.SameProcedureDefinition(state, procedure.Definition) {
+		procedure.Parameters, procedure.ExecuteAs, procedure.Body = state.Parameters, state.ExecuteAs, state.Body
+	}
+
+	return procedure.ToSchema(d)
+}
+
+func UpdateStoredProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	procedure := new(model.StoredProcedure).Parse(d)
+
+	if err := connector.CreateOrAlterStoredProcedure(ctx, procedure); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStoredProcedure(ctx, d, meta)
+}
+
+func DeleteStoredProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	procedure := new(model.StoredProcedure).Parse(d)
+
+	err := connector.DeleteStoredProcedure(ctx, procedure.Database, procedure.Schema, procedure.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportStoredProcedure(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadStoredProcedure(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("stored procedure '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
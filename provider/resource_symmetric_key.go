@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+var symmetricKeyEncryptions = []string{"encryption_certificates", "encryption_symmetric_keys", "encryption_asymmetric_keys", "password"}
+
+func ResourceSymmetricKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSymmetricKey,
+		ReadContext:   ReadSymmetricKey,
+		UpdateContext: UpdateSymmetricKey,
+		DeleteContext: DeleteSymmetricKey,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportSymmetricKey,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the key, provider database by default",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotMatch(regexp.MustCompile("^#"), "temporary keys are not supported"),
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AES_128", "AES_192", "AES_256", "TRIPLE_DES_3KEY"}, false),
+			},
+			"key_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ForceNew:     true,
+				RequiredWith: []string{"identity_value"},
+				Description:  "Pass phrase the key is derived from, so the same key can be created in other databases",
+			},
+			"identity_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ForceNew:     true,
+				RequiredWith: []string{"key_source"},
+				Description:  "Phrase the GUID of the key is derived from, so the same key can be created in other databases",
+			},
+			"encryption_certificates": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: symmetricKeyEncryptions,
+				Description:  "Certificates encrypting the key",
+			},
+			"encryption_symmetric_keys": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: symmetricKeyEncryptions,
+				Description:  "Symmetric keys encrypting the key",
+			},
+			"encryption_asymmetric_keys": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: symmetricKeyEncryptions,
+				Description:  "Asymmetric keys encrypting the key",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				AtLeastOneOf: symmetricKeyEncryptions,
+				Description:  "Password encrypting the key, not read back from the server",
+			},
+			"key_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"symmetric_key_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateSymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.SymmetricKey).Parse(d)
+	if key.Database == "" {
+		key.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateSymmetricKey(ctx, key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", key.Database, key.Name))
+	return ReadSymmetricKey(ctx, d, meta)
+}
+
+func ReadSymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid symmetric key ID '%s', expected database/name", d.Id())
+	}
+
+	key, err := connector.GetSymmetricKey(ctx, parts[0], parts[1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if key == nil {
+		log.Printf("[WARN] Symmetric key (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return key.ToSchema(d)
+}
+
+func UpdateSymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.SymmetricKey).Parse(d)
+
+	oldKey := *key
+	oldKey.Certificates = oldStringSet(d, "encryption_certificates")
+	oldKey.SymmetricKeys = oldStringSet(d, "encryption_symmetric_keys")
+	oldKey.AsymmetricKeys = oldStringSet(d, "encryption_asymmetric_keys")
+	oldPassword, _ := d.GetChange("password")
+	oldKey.Password = oldPassword.(string)
+
+	if err := connector.AlterSymmetricKey(ctx, &oldKey, key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadSymmetricKey(ctx, d, meta)
+}
+
+func DeleteSymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	key := new(model.SymmetricKey).Parse(d)
+
+	err := connector.DeleteSymmetricKey(ctx, key.Database, key.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportSymmetricKey(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadSymmetricKey(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("symmetric key '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func oldStringSet(d *schema.ResourceData, key string) []string {
+	old, _ := d.GetChange(key)
+	values := make([]string, 0)
+	for _, value := range old.(*schema.Set).List() {
+		values = append(values, value.(string))
+	}
+	return values
+}
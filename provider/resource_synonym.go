@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceSynonym() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateSynonym,
+		ReadContext:   ReadSynonym,
+		DeleteContext: DeleteSynonym,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportSynonym,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the synonym, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"base_server": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"base_database"},
+				Description:  "Linked server of the base object, for four-part names",
+			},
+			"base_database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Database of the base object, the database of the synonym by default",
+			},
+			"base_schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"base_object": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the table, view, procedure or function the synonym stands for",
+			},
+			"base_object_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the base object as stored by the server, e.g. [app].[dbo].[orders]",
+			},
+			"server": forceNewServerSchema(),
+		},
+	}
+}
+
+func CreateSynonym(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	synonym := new(model.Synonym).Parse(d)
+	if synonym.Database == "" {
+		synonym.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateSynonym(ctx, synonym); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", synonym.Database, synonym.Schema, synonym.Name))
+	return ReadSynonym(ctx, d, meta)
+}
+
+func ReadSynonym(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid synonym ID '%s', expected database/schema/name", d.Id())
+	}
+
+	synonym, err := connector.GetSynonym(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if synonym == nil {
+		log.Printf("[WARN] Synonym (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return synonym.ToSchema(d)
+}
+
+func DeleteSynonym(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	synonym := new(model.Synonym).Parse(d)
+
+	err := connector.DeleteSynonym(ctx, synonym.Database, synonym.Schema, synonym.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportSynonym(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadSynonym(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("synonym '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceTable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateTable,
+		ReadContext:   ReadTable,
+		UpdateContext: UpdateTable,
+		DeleteContext: DeleteTable,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportTable,
+		},
+		CustomizeDiff: destructiveTableDiff,
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the table, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"column": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringMatch(columnType, "must be a SQL type, e.g. int, decimal(18, 2) or nvarchar(max)"),
+							DiffSuppressFunc: sameSqlType,
+						},
+						"nullable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"identity": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"identity_seed": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"identity_increment": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"default": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: sameSqlExpression,
+							Description:      "Expression of the default constraint of the column, e.g. 0 or SYSUTCDATETIME()",
+						},
+					},
+				},
+				Description: "Columns of the table in order",
+			},
+			"primary_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Name of the constraint, generated by the server by default",
+						},
+						"columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"clustered": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"unique": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"clustered": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"check": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"expression": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: sameSqlExpression,
+						},
+					},
+				},
+			},
+			"allow_destructive_changes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Apply the changes dropping columns, narrowing their types or recreating the table, which fail the plan otherwise",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+var columnType = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*\s*(\(\s*(\d+|[Mm][Aa][Xx])\s*(,\s*\d+\s*)?\))?$`)
+
+// sqlExpressionNoise are the parentheses, brackets and spaces the server adds to or removes from expressions
+var sqlExpressionNoise = regexp.MustCompile(`[\s()\[\]]`)
+
+// sameSqlExpression ignores the case, the spaces, the parentheses and the brackets of default and check expressions,
+// e.g. amount > 0 is read back as ([amount]>(0))
+func sameSqlExpression(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(sqlExpressionNoise.ReplaceAllString(old, ""), sqlExpressionNoise.ReplaceAllString(new, ""))
+}
+
+// integerTypes are the integer types in the order of their ranges
+var integerTypes = []string{"tinyint", "smallint", "int", "bigint"}
+
+var sqlTypeSize = regexp.MustCompile(`^([a-z0-9_]+)(\((\d+|max)(,(\d+))?\))?$`)
+
+// widensSqlType tells whether converting values of the old type to the new type never loses data:
+// larger lengths and larger integer types, or precisions and scales leaving room for the same digits
+func widensSqlType(old, new string) bool {
+	old, new = normalizeSqlType(old), normalizeSqlType(new)
+	if old == new {
+		return true
+	}
+	oldParts, newParts := sqlTypeSize.FindStringSubmatch(old), sqlTypeSize.FindStringSubmatch(new)
+	if oldParts == nil || newParts == nil {
+		return false
+	}
+	oldBase, newBase := oldParts[1], newParts[1]
+	oldRank, newRank := indexOf(integerTypes, oldBase), indexOf(integerTypes, newBase)
+	if oldRank >= 0 && newRank >= 0 {
+		return newRank >= oldRank
+	}
+
+	switch {
+	case oldBase == newBase && (oldBase == "decimal" || oldBase == "numeric"):
+		oldPrecision, _ := strconv.Atoi(oldParts[3])
+		oldScale, _ := strconv.Atoi(oldParts[5])
+		newPrecision, _ := strconv.Atoi(newParts[3])
+		newScale, _ := strconv.Atoi(newParts[5])
+		return newScale >= oldScale && newPrecision-newScale >= oldPrecision-oldScale
+	case oldBase == newBase || oldBase == "varchar" && newBase == "nvarchar" || oldBase == "char" && newBase == "nchar" ||
+		oldBase == "char" && newBase == "varchar" || oldBase == "nchar" && newBase == "nvarchar" || oldBase == "binary" && newBase == "varbinary":
+		if newParts[3] == "max" {
+			return true
+		}
+		if oldParts[3] == "max" || oldParts[3] == "" || newParts[3] == "" {
+			return false
+		}
+		oldLength, _ := strconv.Atoi(oldParts[3])
+		newLength, _ := strconv.Atoi(newParts[3])
+		return newLength >= oldLength
+	}
+	return false
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// destructiveTableDiff fails the plan of changes losing data unless allow_destructive_changes is set: dropped
+// columns and narrowed types, applied in place, and changed identities, which replace the table. Columns renamed at
+// the same position are renamed in place and keep their data.
+func destructiveTableDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("column") {
+		return nil
+	}
+	oldValue, newValue := d.GetChange("column")
+	newColumns := make(map[string]map[string]interface{})
+	newNames := make([]string, 0)
+	for _, value := range newValue.([]interface{}) {
+		column := value.(map[string]interface{})
+		newColumns[column["name"].(string)] = column
+		newNames = append(newNames, column["name"].(string))
+	}
+	oldNames := make([]string, 0)
+	for _, value := range oldValue.([]interface{}) {
+		oldNames = append(oldNames, value.(map[string]interface{})["name"].(string))
+	}
+	renamed := model.RenamedColumns(oldNames, newNames)
+
+	changes := make([]string, 0)
+	replace := false
+	for _, value := range oldValue.([]interface{}) {
+		old := value.(map[string]interface{})
+		name := old["name"].(string)
+		newName, ok := renamed[name]
+		if !ok {
+			newName = name
+		}
+		new, ok := newColumns[newName]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("column %s is dropped", name))
+		case old["identity"] != new["identity"] || new["identity"].(bool) &&
+			(old["identity_seed"] != new["identity_seed"] || old["identity_increment"] != new["identity_increment"]):
+			changes = append(changes, fmt.Sprintf("identity of column %s changes, replacing the table", name))
+			replace = true
+		case !widensSqlType(old["type"].(string), new["type"].(string)):
+			changes = append(changes, fmt.Sprintf("column %s is narrowed from %s to %s", name, old["type"], new["type"]))
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	if !d.Get("allow_destructive_changes").(bool) {
+		return fmt.Errorf("destructive changes of table %s: %s; set allow_destructive_changes to apply them",
+			d.Get("name"), strings.Join(changes, ", "))
+	}
+	if replace {
+		return d.ForceNew("column")
+	}
+	return nil
+}
+
+func CreateTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	table := new(model.Table).Parse(d)
+	if table.Database == "" {
+		table.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateTable(ctx, table); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", table.Database, table.Schema, table.Name))
+	return ReadTable(ctx, d, meta)
+}
+
+func ReadTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid table ID '%s', expected database/schema/name", d.Id())
+	}
+
+	table, err := connector.GetTable(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if table == nil {
+		log.Printf("[WARN] Table (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// keep the columns and constraints in the order they are declared, added columns are at the end of the table
+	state := new(model.Table).Parse(d)
+	columnOrder := make(map[string]int)
+	for i, column := range state.Columns {
+		columnOrder[column.Name] = i
+	}
+	sortByName(len(table.Columns), func(i int) string { return table.Columns[i].Name }, columnOrder,
+		func(i, j int) { table.Columns[i], table.Columns[j] = table.Columns[j], table.Columns[i] })
+	uniqueOrder := make(map[string]int)
+	for i, key := range state.Unique {
+		uniqueOrder[key.Name] = i
+	}
+	sortByName(len(table.Unique), func(i int) string { return table.Unique[i].Name }, uniqueOrder,
+		func(i, j int) { table.Unique[i], table.Unique[j] = table.Unique[j], table.Unique[i] })
+	checkOrder := make(map[string]int)
+	for i, check := range state.Checks {
+		checkOrder[check.Name] = i
+	}
+	sortByName(len(table.Checks), func(i int) string { return table.Checks[i].Name }, checkOrder,
+		func(i, j int) { table.Checks[i], table.Checks[j] = table.Checks[j], table.Checks[i] })
+
+	return table.ToSchema(d)
+}
+
+// sortByName sorts the n items stably in the order of their names, the items missing from order last
+func sortByName(n int, name func(int) string, order map[string]int, swap func(i, j int)) {
+	position := func(i int) int {
+		if p, ok := order[name(i)]; ok {
+			return p
+		}
+		return len(order)
+	}
+	for i := 1; i < n; i++ {
+		for j := i; j > 0 && position(j-1) > position(j); j-- {
+			swap(j-1, j)
+		}
+	}
+}
+
+func UpdateTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	table := new(model.Table).Parse(d)
+
+	if d.HasChanges("column", "primary_key", "unique", "check") {
+		old := new(model.Table).ParseOld(d)
+		if err := connector.AlterTable(ctx, old, table); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadTable(ctx, d, meta)
+}
+
+func DeleteTable(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	table := new(model.Table).Parse(d)
+
+	err := connector.DeleteTable(ctx, table.Database, table.Schema, table.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportTable(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadTable(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("table '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
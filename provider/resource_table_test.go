@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestWidensSqlType(t *testing.T) {
+	cases := []struct {
+		old, new string
+		widens   bool
+	}{
+		{"int", "INT", true},
+		{"int", "bigint", true},
+		{"bigint", "int", false},
+		{"varchar(50)", "varchar(100)", true},
+		{"varchar(100)", "varchar(50)", false},
+		{"varchar(50)", "nvarchar(max)", true},
+		{"nvarchar(max)", "nvarchar(4000)", false},
+		{"nvarchar(50)", "varchar(50)", false},
+		{"char(10)", "varchar(10)", true},
+		{"decimal(10, 2)", "decimal(12,2)", true},
+		{"decimal(10, 2)", "decimal(10, 4)", false},
+		{"decimal", "decimal(20, 2)", true},
+		{"datetime", "date", false},
+	}
+	for _, c := range cases {
+		if widens := widensSqlType(c.old, c.new); widens != c.widens {
+			t.Errorf("widensSqlType(%q, %q) = %t, expected %t", c.old, c.new, widens, c.widens)
+		}
+	}
+}
+
+func TestSameSqlExpression(t *testing.T) {
+	if !sameSqlExpression("", "([amount]>(0))", "amount > 0", nil) {
+		t.Error("check read back by the server differs from the configured one")
+	}
+	if !sameSqlExpression("", "(sysutcdatetime())", "SYSUTCDATETIME()", nil) {
+		t.Error("default read back by the server differs from the configured one")
+	}
+	if sameSqlExpression("", "((0))", "1", nil) {
+		t.Error("different defaults are the same")
+	}
+}
+
+func TestAccTable_addColumn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_table.test", "id", "master/dbo/tf_acc_table"),
+					resource.TestCheckResourceAttr("mssql_table.test", "column.#", "2"),
+				),
+			},
+			{
+				Config: testAccTableConfig_added,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_table.test", "column.#", "3"),
+					resource.TestCheckResourceAttr("mssql_table.test", "column.2.name", "created_at"),
+				),
+			},
+			{
+				Config:      testAccTableConfig_basic,
+				ExpectError: regexp.MustCompile("column created_at is dropped"),
+			},
+			{
+				ResourceName:            "mssql_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_destructive_changes"},
+			},
+		},
+	})
+}
+
+func TestAccTable_alterColumns(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_value("tf_acc_table_alter", "value", "nvarchar(200)", true, ""),
+			},
+			{
+				Config: testAccTableConfig_value("tf_acc_table_alter", "value", "nvarchar(400)", false, "N''"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_table.test", "column.1.type", "nvarchar(400)"),
+					resource.TestCheckResourceAttr("mssql_table.test", "column.1.nullable", "false"),
+					resource.TestCheckResourceAttr("mssql_table.test", "column.1.default", "(N'')"),
+				),
+			},
+			{
+				Config:      testAccTableConfig_value("tf_acc_table_alter", "value", "nvarchar(100)", false, "N''"),
+				ExpectError: regexp.MustCompile("column value is narrowed from nvarchar\\(400\\) to nvarchar\\(100\\)"),
+			},
+			{
+				Config: testAccTableConfig_dropped,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_table.test", "column.#", "1"),
+					resource.TestCheckResourceAttr("mssql_table.test", "column.0.name", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTable_renameColumn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_value("tf_acc_table_rename", "value", "nvarchar(200)", true, ""),
+			},
+			{
+				PreConfig: testAccExec(t, "INSERT INTO [master].[dbo].[tf_acc_table_rename] ([value]) VALUES (N'kept')"),
+				Config:    testAccTableConfig_value("tf_acc_table_rename", "label", "nvarchar(200)", true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_table.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("mssql_table.test", "column.1.name", "label"),
+					testAccCheckExec(t, `IF NOT EXISTS (SELECT 1 FROM [master].[dbo].[tf_acc_table_rename] WHERE [label] = N'kept')
+						THROW 50000, 'row lost by the rename', 1`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTable_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_value("tf_acc_table_identity", "value", "nvarchar(200)", true, ""),
+			},
+			{
+				Config:      testAccTableConfig_identitySeed,
+				ExpectError: regexp.MustCompile("identity of column id changes, replacing the table"),
+			},
+		},
+	})
+}
+
+func TestAccTable_constraints(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_constraints(`["code"]`, "len([code]) > 0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_table.test", "unique.#", "1"),
+					resource.TestCheckResourceAttr("mssql_table.test", "unique.0.columns.#", "1"),
+					resource.TestCheckResourceAttr("mssql_table.test", "check.#", "1"),
+				),
+			},
+			{
+				Config: testAccTableConfig_constraints(`["code", "value"]`, "len([code]) > 2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_table.test", "unique.0.columns.#", "2"),
+					resource.TestCheckResourceAttr("mssql_table.test", "unique.0.columns.1", "value"),
+					resource.TestCheckResourceAttr("mssql_table.test", "check.0.expression", "(len([code])>(2))"),
+				),
+			},
+			{
+				Config: testAccTableConfig_noConstraints,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_table.test", "unique.#", "0"),
+					resource.TestCheckResourceAttr("mssql_table.test", "check.#", "0"),
+				),
+			},
+			{
+				ResourceName:            "mssql_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_destructive_changes"},
+			},
+		},
+	})
+}
+
+const testAccTableConfig_basic = `
+resource "mssql_table" "test" {
+		database = "master"
+		name     = "tf_acc_table"
+
+		column {
+				name     = "id"
+				type     = "int"
+				nullable = false
+				identity = true
+		}
+		column {
+				name = "value"
+				type = "nvarchar(200)"
+		}
+
+		primary_key {
+				columns = ["id"]
+		}
+}`
+
+const testAccTableConfig_added = `
+resource "mssql_table" "test" {
+		database = "master"
+		name     = "tf_acc_table"
+
+		column {
+				name     = "id"
+				type     = "int"
+				nullable = false
+				identity = true
+		}
+		column {
+				name = "value"
+				type = "nvarchar(200)"
+		}
+		column {
+				name     = "created_at"
+				type     = "datetime2(0)"
+				nullable = false
+				default  = "SYSUTCDATETIME()"
+		}
+
+		primary_key {
+				columns = ["id"]
+		}
+}`
+
+func testAccTableConfig_value(name, column, columnType string, nullable bool, defaultValue string) string {
+	return fmt.Sprintf(`
+resource "mssql_table" "test" {
+		database = "master"
+		name     = "%s"
+
+		column {
+				name     = "id"
+				type     = "int"
+				nullable = false
+				identity = true
+		}
+		column {
+				name     = "%s"
+				type     = "%s"
+				nullable = %t
+				default  = "%s"
+		}
+
+		primary_key {
+				columns = ["id"]
+		}
+}`, name, column, columnType, nullable, defaultValue)
+}
+
+const testAccTableConfig_dropped = `
+resource "mssql_table" "test" {
+		database                  = "master"
+		name                      = "tf_acc_table_alter"
+		allow_destructive_changes = true
+
+		column {
+				name     = "id"
+				type     = "int"
+				nullable = false
+				identity = true
+		}
+
+		primary_key {
+				columns = ["id"]
+		}
+}`
+
+const testAccTableConfig_identitySeed = `
+resource "mssql_table" "test" {
+		database = "master"
+		name     = "tf_acc_table_identity"
+
+		column {
+				name          = "id"
+				type          = "int"
+				nullable      = false
+				identity      = true
+				identity_seed = 100
+		}
+		column {
+				name = "value"
+				type = "nvarchar(200)"
+		}
+
+		primary_key {
+				columns = ["id"]
+		}
+}`
+
+func testAccTableConfig_constraints(uniqueColumns, check string) string {
+	return fmt.Sprintf(`
+resource "mssql_table" "test" {
+		database = "master"
+		name     = "tf_acc_table_constraints"
+
+		column {
+				name     = "id"
+				type     = "int"
+				nullable = false
+				identity = true
+		}
+		column {
+				name = "value"
+				type = "nvarchar(200)"
+		}
+		column {
+				name     = "code"
+				type     = "varchar(20)"
+				nullable = false
+				default  = "'none'"
+		}
+
+		primary_key {
+				columns = ["id"]
+		}
+		unique {
+				name    = "UQ_tf_acc_table_constraints_code"
+				columns = %s
+		}
+		check {
+				name       = "CK_tf_acc_table_constraints_code"
+				expression = "%s"
+		}
+}`, uniqueColumns, check)
+}
+
+const testAccTableConfig_noConstraints = `
+resource "mssql_table" "test" {
+		database = "master"
+		name     = "tf_acc_table_constraints"
+
+		column {
+				name     = "id"
+				type     = "int"
+				nullable = false
+				identity = true
+		}
+		column {
+				name = "value"
+				type = "nvarchar(200)"
+		}
+		column {
+				name     = "code"
+				type     = "varchar(20)"
+				nullable = false
+				default  = "'none'"
+		}
+
+		primary_key {
+				columns = ["id"]
+		}
+}`
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+func ResourceTableTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateTableTrigger,
+		ReadContext:   ReadTableTrigger,
+		UpdateContext: UpdateTableTrigger,
+		DeleteContext: DeleteTableTrigger,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportTableTrigger,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the trigger, provider database by default",
+			},
+			"schema": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "dbo",
+				Description: "Schema of the table or view, and of the trigger",
+			},
+			"table": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Table or view the trigger is defined on",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"timing": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AFTER",
+				ValidateFunc: validation.StringInSlice([]string{"AFTER", "INSTEAD OF"}, false),
+				Description:  "AFTER the statements firing the trigger succeed, or INSTEAD OF them",
+			},
+			"events": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"INSERT", "UPDATE", "DELETE"}, false),
+				},
+				Description: "DML statements firing the trigger",
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "T-SQL statements of the trigger, following AS",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"order": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"FIRST", "LAST", "NONE"}, false),
+				Description:  "Fire the AFTER trigger FIRST or LAST among the triggers of each of its events",
+			},
+			"definition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CREATE TRIGGER statement read back from the database",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateTableTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger := new(model.TableTrigger).Parse(d)
+	if trigger.Database == "" {
+		trigger.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateOrAlterTableTrigger(ctx, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", trigger.Database, trigger.Schema, trigger.Name))
+	return ReadTableTrigger(ctx, d, meta)
+}
+
+func ReadTableTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid table trigger ID '%s', expected database/schema/name", d.Id())
+	}
+
+	trigger, err := connector.GetTableTrigger(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if trigger == nil {
+		log.Printf("[WARN] Table trigger (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// keep the body as written while it creates the definition read back
+	state := new(model.TableTrigger).Parse(d)
+	state.Schema, state.Name, state.Table = trigger.Schema, trigger.Name, trigger.Table
+	state.Timing, state.Events = trigger.Timing, trigger.Events
+	if trigger.Definition == "" || mssql.SameTableTriggerDefinition(state, trigger.Definition) {
+		trigger.Body = state.Body
+	}
+
+	return trigger.ToSchema(d)
+}
+
+func UpdateTableTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger := new(model.TableTrigger).Parse(d)
+
+	var err error
+	switch {
+	case d.HasChanges("timing", "events", "body"):
+		err = connector.CreateOrAlterTableTrigger(ctx, trigger)
+	case d.HasChange("enabled"):
+		err = connector.SetTableTriggerEnabled(ctx, trigger, trigger.Enabled)
+		if err == nil && d.HasChange("order") {
+			err = connector.SetTableTriggerOrder(ctx, trigger)
+		}
+	default:
+		err = connector.SetTableTriggerOrder(ctx, trigger)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadTableTrigger(ctx, d, meta)
+}
+
+func DeleteTableTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	trigger := new(model.TableTrigger).Parse(d)
+
+	err := connector.DeleteTableTrigger(ctx, trigger.Database, trigger.Schema, trigger.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportTableTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadTableTrigger(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("table trigger '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
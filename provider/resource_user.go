@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,76 +22,123 @@ func ResourceUser() *schema.Resource {
 			StateContext: ImportUser,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"database": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "In which database this user will be created",
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    (&schema.Resource{Schema: userSchema()}).CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeUserStateV0,
 			},
-			"username": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"password": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Sensitive:     true,
-				Description:   "User password",
-				ConflictsWith: []string{"login_name"},
-			},
-			"login_name": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Description:   "Create user for existing [login] from 'master' database or Windows login name",
-				ConflictsWith: []string{"object_id", "principal_id"},
+			{
+				Version: 1,
+				Type:    (&schema.Resource{Schema: userSchema()}).CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeUserStateV1,
 			},
+		},
 
-			"object_id": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Description:   "External object ID",
-				ConflictsWith: []string{"login_name", "principal_id"},
-			},
-			"principal_id": {
-				Type:          schema.TypeInt,
-				Optional:      true,
-				ForceNew:      true,
-				Description:   "Create user for existing [Principal ID] from 'master' database",
-				ConflictsWith: []string{"object_id", "login_name"},
-			},
-			"auth_type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "DATABASE",
-				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					allowedValues := []string{"DATABASE", "INSTANCE", "EXTERNAL"}
-					if !funk.ContainsString(allowedValues, val.(string)) {
-						errs = append(errs, fmt.Errorf("auth_type must be one of: %s", allowedValues))
-					}
-					return
-				},
+		Schema: userSchema(),
+	}
+}
+
+func userSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"database": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "In which database this user will be created",
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			StateFunc: func(src interface{}) string {
+				return "" // Do not store password in state, actually
 			},
-			"options": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     schema.TypeString,
+			ConflictsWith: []string{"login_name"},
+			Description:   "Password of contained database users, never stored in state, so changes are applied when password_version changes",
+		},
+		"password_version": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"password"},
+			Description:  "Arbitrary value, changing it sets the password again, e.g. for rotation",
+		},
+		"login_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Description:   "Create user for existing [login] from 'master' database or Windows login name",
+			ConflictsWith: []string{"object_id", "principal_id"},
+		},
+
+		"object_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Description:   "External object ID",
+			ConflictsWith: []string{"login_name", "principal_id"},
+		},
+		"is_group": {
+			Type:         schema.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"object_id"},
+			Description:  "Whether object_id is a group, rather than a user or an application",
+		},
+		"principal_id": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			Description:   "Principal ID of the user in the database, used in resource ID so the user is tracked across renames",
+			ConflictsWith: []string{"object_id", "login_name"},
+		},
+		"auth_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "DATABASE",
+			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+				allowedValues := []string{"DATABASE", "INSTANCE", "EXTERNAL"}
+				if !funk.ContainsString(allowedValues, val.(string)) {
+					errs = append(errs, fmt.Errorf("auth_type must be one of: %s", allowedValues))
+				}
+				return
 			},
-			"roles": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
+		},
+		"default_schema": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Schema of the objects the user references without schema name, dbo if not set",
+		},
+		"options": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     schema.TypeString,
+		},
+		"roles": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
 		},
+		"kill_sessions_on_destroy": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Kill active sessions of the user before dropping it",
+		},
+		"server": serverSchema(),
 	}
 }
 
 func CreateUser(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(data, meta)
 	user := new(model.User).Parse(data)
 
 	err := connector.CreateUser(ctx, user)
@@ -98,41 +146,79 @@ func CreateUser(ctx context.Context, data *schema.ResourceData, meta interface{}
 		return diag.FromErr(err)
 	}
 
-	userId := fmt.Sprintf("%s/%s", user.Database, user.Username)
-	data.SetId(userId)
-
-	return nil
+	data.SetId(fmt.Sprintf("%s/%s", user.Database, user.Username))
+	return ReadUser(ctx, data, meta)
 }
 
 func UpdateUser(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(data, meta)
 	if err := connector.PingContext(ctx); err != nil {
 		return diag.FromErr(err)
 	}
 	user := new(model.User).Parse(data)
 
+	if data.HasChange("username") {
+		oldName, _ := data.GetChange("username")
+		if err := connector.RenameUser(ctx, user.Database, oldName.(string), user.Username); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("password_version") {
+		// password is not in state, the configuration holds the new one
+		password := configString(data, "password")
+		if password == "" {
+			return diag.Errorf("user %s: password_version requires password", user.Username)
+		}
+		if err := connector.SetUserPassword(ctx, user.Database, user.Username, password); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	err := connector.UpdateUser(ctx, connector.Database, user)
 	return diag.FromErr(err)
 }
 
 func ReadUser(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
-	database, username, err := mssql.ParseUserId(data.Id())
+	connector := resourceConnector(data, meta)
+	user, err := getUserById(ctx, connector, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	user, err := connector.GetUser(ctx, database, username)
-	diags := diag.FromErr(err)
+	if user == nil {
+		log.Printf("[WARN] User (%s) not found; removing from state", data.Id())
+		data.SetId("")
+		return nil
+	}
+
+	data.SetId(fmt.Sprintf("%s/%d", user.Database, user.PrincipalID))
+	return user.ToSchema(data)
+}
+
+// getUserById resolves database/principal_id ID, or database/username of legacy and imported resources
+func getUserById(ctx context.Context, connector *mssql.Connector, id string) (*model.User, error) {
+	database, principalID, username, err := mssql.ParsePrincipalId(id)
+	if err != nil {
+		return nil, err
+	}
 
-	if user != nil {
-		data.SetId(fmt.Sprintf("%s/%s", user.Database, user.Username))
-		diags = append(diags, user.ToSchema(data)...)
+	if principalID != 0 {
+		return connector.GetUserByPrincipalID(ctx, database, principalID)
 	}
-	return diags
+	return connector.GetUser(ctx, database, username)
 }
 
 func DeleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	connector := meta.(*mssql.Connector)
+	connector := resourceConnector(d, meta)
 	user := new(model.User).Parse(d)
 
+	if d.Get("kill_sessions_on_destroy").(bool) {
+		if err := connector.KillUserSessions(ctx, user.Database, user.Username); err != nil {
+			log.Printf("[WARN] Killing sessions of user %s: %s", user.Username, err)
+		}
+	}
+
 	err := connector.DeleteUser(ctx, user)
 	if err == nil {
 		d.SetId("")
@@ -141,22 +227,57 @@ func DeleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 }
 
 func ImportUser(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	database, username, err := mssql.ParseUserId(d.Id())
+	connector := resourceConnector(d, meta)
+	user, err := getUserById(ctx, connector, d.Id())
 	if err != nil {
 		return nil, err
 	}
 
-	connector := meta.(*mssql.Connector)
-	user, err := connector.GetUser(ctx, database, username)
-	if err != nil {
-		return nil, err
+	if user == nil {
+		// Numeric usernames are valid too
+		database, username, _ := mssql.ParseUserId(d.Id())
+		user, err = connector.GetUser(ctx, database, username)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if user == nil {
 		return nil, fmt.Errorf("user '%s' not found", d.Id())
 	}
 
+	d.SetId(fmt.Sprintf("%s/%d", user.Database, user.PrincipalID))
 	user.ToSchema(d)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// upgradeUserStateV0 replaces database/username ID used by version 0 with database/principal_id
+func upgradeUserStateV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	connector, ok := meta.(*mssql.Connector)
+	id, _ := rawState["id"].(string)
+	if !ok || id == "" {
+		return rawState, nil
+	}
+
+	database, username, err := mssql.ParseUserId(id)
+	if err != nil {
+		return rawState, nil
+	}
+	user, err := connector.GetUser(ctx, database, username)
+	if err != nil || user == nil {
+		// Keep the name, Read falls back to lookup by name
+		log.Printf("[WARN] User (%s) principal ID lookup failed during state upgrade: %v", id, err)
+		return rawState, nil
+	}
+
+	rawState["id"] = fmt.Sprintf("%s/%d", user.Database, user.PrincipalID)
+	rawState["principal_id"] = user.PrincipalID
+	return rawState, nil
+}
+
+// upgradeUserStateV1 removes the password stored in state by version 1
+func upgradeUserStateV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	rawState["password"] = ""
+	return rawState, nil
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUser_renameOutsideTerraform(t *testing.T) {
+	var id string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic("tf_acc_user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_user.test", "username", "tf_acc_user"),
+					resource.TestCheckResourceAttrSet("mssql_user.test", "principal_id"),
+					testAccStoreAttr("mssql_user.test", "id", &id),
+				),
+			},
+			{
+				// Renamed user is still found by principal ID and renamed back in place, rather than recreated
+				PreConfig: testAccExec(t, "USE [master]; ALTER USER [tf_acc_user] WITH NAME = [tf_acc_user_renamed]"),
+				Config:    testAccUserConfig_basic("tf_acc_user"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_user.test", "username", "tf_acc_user"),
+					resource.TestCheckResourceAttrPtr("mssql_user.test", "id", &id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUser_defaultSchema(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_defaultSchema("dbo"),
+				Check:  resource.TestCheckResourceAttr("mssql_user.test", "default_schema", "dbo"),
+			},
+			{
+				// Altered in place
+				Config: testAccUserConfig_defaultSchema("sys"),
+				Check:  resource.TestCheckResourceAttr("mssql_user.test", "default_schema", "sys"),
+			},
+		},
+	})
+}
+
+func TestAccUser_passwordVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: TestProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: testAccExec(t, "EXEC sp_configure 'contained database authentication', 1; RECONFIGURE"),
+				Config:    testAccUserConfig_passwordVersion("Tf-Acc-Pa55word!", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_user.test", "password", ""),
+				),
+			},
+			{
+				// Password is altered in place and still not stored
+				Config: testAccUserConfig_passwordVersion("Tf-Acc-Pa55word-2!", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mssql_user.test", "password_version", "2"),
+					resource.TestCheckResourceAttr("mssql_user.test", "password", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserConfig_passwordVersion(password string, version string) string {
+	return fmt.Sprintf(`
+resource "mssql_database" "test" {
+		name        = "tf_acc_user_contained"
+		containment = "PARTIAL"
+}
+
+resource "mssql_user" "test" {
+		database         = mssql_database.test.name
+		username         = "tf_acc_user_contained"
+		password         = "%s"
+		password_version = "%s"
+}`, password, version)
+}
+
+func testAccUserConfig_defaultSchema(schema string) string {
+	return fmt.Sprintf(`
+resource "mssql_login" "test" {
+		name     = "tf_acc_user_schema_login"
+		password = "Tf-Acc-Pa55word!"
+}
+
+resource "mssql_user" "test" {
+		database       = "master"
+		username       = "tf_acc_user_schema"
+		login_name     = mssql_login.test.name
+		auth_type      = "INSTANCE"
+		default_schema = "%s"
+}`, schema)
+}
+
+func testAccUserConfig_basic(username string) string {
+	return fmt.Sprintf(`
+resource "mssql_login" "test" {
+		name     = "tf_acc_user_login"
+		password = "Tf-Acc-Pa55word!"
+}
+
+resource "mssql_user" "test" {
+		database   = "master"
+		username   = "%s"
+		login_name = mssql_login.test.name
+		auth_type  = "INSTANCE"
+}`, username)
+}
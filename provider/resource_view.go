@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/model"
+)
+
+func ResourceView() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: CreateView,
+		ReadContext:   ReadView,
+		UpdateContext: UpdateView,
+		DeleteContext: DeleteView,
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportView,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Database of the view, provider database by default",
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "dbo",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameModuleBody,
+				Description:      "SELECT statement of the view, following AS",
+			},
+			"schemabinding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Bind the view to the schema of the underlying objects, which can not be changed in ways affecting it",
+			},
+			"definition": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CREATE VIEW statement read back from the database",
+			},
+			"server": serverSchema(),
+		},
+	}
+}
+
+func CreateView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	view := new(model.View).Parse(d)
+	if view.Database == "" {
+		view.Database = defaultDatabase(connector)
+	}
+
+	if err := connector.CreateView(ctx, view); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", view.Database, view.Schema, view.Name))
+	return ReadView(ctx, d, meta)
+}
+
+func ReadView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid view ID '%s', expected database/schema/name", d.Id())
+	}
+
+	view, err := connector.GetView(ctx, parts[0], parts[1], parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if view == nil {
+		log.Printf("[WARN] View (%s) not found; removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if view.Body == "" {
+		// encrypted, or the definition could not be parsed
+		view.Body = d.Get("body").(string)
+	}
+
+	return view.ToSchema(d)
+}
+
+func UpdateView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	view := new(model.View).Parse(d)
+
+	if err := connector.AlterView(ctx, view); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadView(ctx, d, meta)
+}
+
+func DeleteView(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connector := resourceConnector(d, meta)
+	view := new(model.View).Parse(d)
+
+	err := connector.DeleteView(ctx, view.Database, view.Schema, view.Name)
+	if err == nil {
+		d.SetId("")
+	}
+	return diag.FromErr(err)
+}
+
+func ImportView(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	diags := ReadView(ctx, d, meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf(diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("view '%s' not found", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
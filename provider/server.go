@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rbernardini/terraform-provider-mssql/mssql"
+)
+
+// serverSchema is the server block of the resources, overriding the server of the provider,
+// so that one provider configuration can manage several instances, e.g. with for_each
+func serverSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Server managing the resource instead of the one of the provider",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"endpoint": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  1433,
+					ForceNew: true,
+				},
+				"instance": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"database": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Database to connect to, defaults to the one of the provider",
+				},
+				"username": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{"server.0.password"},
+					Description:  "SQL login, the credentials of the provider are used if not set",
+				},
+				"password": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					RequiredWith: []string{"server.0.username"},
+				},
+			},
+		},
+	}
+}
+
+// forceNewServerSchema is the server block of the resources which cannot be updated
+func forceNewServerSchema() *schema.Schema {
+	server := serverSchema()
+	server.ForceNew = true
+	return server
+}
+
+// resourceConnector returns the connector of the server block of the resource, or the one of the provider
+func resourceConnector(data *schema.ResourceData, meta interface{}) *mssql.Connector {
+	connector := meta.(*mssql.Connector)
+	server, ok := data.GetOk("server.0")
+	if !ok {
+		return connector
+	}
+
+	block := server.(map[string]interface{})
+	var login *mssql.LoginUser
+	if username := block["username"].(string); username != "" {
+		login = &mssql.LoginUser{Username: username, Password: block["password"].(string)}
+	}
+	conn := connector.WithServer(block["endpoint"].(string), block["port"].(int), block["instance"].(string), login)
+	if database := block["database"].(string); database != "" {
+		conn.Database = database
+	}
+	return conn
+}